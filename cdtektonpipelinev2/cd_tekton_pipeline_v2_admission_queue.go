@@ -0,0 +1,424 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultAdmissionPollInterval is the delay between two counts of the active runs of a trigger by a
+// PipelineRunAdmissionQueue when PollInterval is not set.
+const DefaultAdmissionPollInterval = 15 * time.Second
+
+// pipelineRunAdmissionActiveStatuses are the statuses of the runs occupying a slot of a trigger.
+var pipelineRunAdmissionActiveStatuses = []RunStatus{
+	RunStatusRunningConst,
+	RunStatusPendingConst,
+	RunStatusQueuedConst,
+	RunStatusWaitingConst,
+}
+
+// AdmitTektonPipelineRunsOptions : The options of a PipelineRunAdmissionQueue.
+type AdmitTektonPipelineRunsOptions struct {
+	// Maximum number of active runs per trigger. When zero, the `max_concurrent_runs` of each trigger is used, and the
+	// runs of triggers without limit are created right away.
+	MaxConcurrentRuns int64 `validate:"gte=0"`
+
+	// Delay between two counts of the active runs of a trigger, while runs are held back. Defaults to
+	// DefaultAdmissionPollInterval.
+	PollInterval time.Duration
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewAdmitTektonPipelineRunsOptions : Instantiate AdmitTektonPipelineRunsOptions
+func (*CdTektonPipelineV2) NewAdmitTektonPipelineRunsOptions() *AdmitTektonPipelineRunsOptions {
+	return &AdmitTektonPipelineRunsOptions{}
+}
+
+// SetMaxConcurrentRuns : Allow user to set MaxConcurrentRuns
+func (_options *AdmitTektonPipelineRunsOptions) SetMaxConcurrentRuns(maxConcurrentRuns int64) *AdmitTektonPipelineRunsOptions {
+	_options.MaxConcurrentRuns = maxConcurrentRuns
+	return _options
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (_options *AdmitTektonPipelineRunsOptions) SetPollInterval(pollInterval time.Duration) *AdmitTektonPipelineRunsOptions {
+	_options.PollInterval = pollInterval
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *AdmitTektonPipelineRunsOptions) SetHeaders(param map[string]string) *AdmitTektonPipelineRunsOptions {
+	options.Headers = param
+	return options
+}
+
+// AdmitTektonPipelineRunOptions : The PipelineRunAdmissionQueue.CreateTektonPipelineRun options.
+type AdmitTektonPipelineRunOptions struct {
+	// The options of the run to create. The trigger must be named, by TriggerName or Trigger.
+	CreateTektonPipelineRunOptions *CreateTektonPipelineRunOptions `validate:"required"`
+
+	// Runs with a higher priority are admitted first. Runs with the same priority are admitted in submission order.
+	Priority int
+}
+
+// NewAdmitTektonPipelineRunOptions : Instantiate AdmitTektonPipelineRunOptions
+func (*CdTektonPipelineV2) NewAdmitTektonPipelineRunOptions(createTektonPipelineRunOptions *CreateTektonPipelineRunOptions) *AdmitTektonPipelineRunOptions {
+	return &AdmitTektonPipelineRunOptions{
+		CreateTektonPipelineRunOptions: createTektonPipelineRunOptions,
+	}
+}
+
+// SetCreateTektonPipelineRunOptions : Allow user to set CreateTektonPipelineRunOptions
+func (_options *AdmitTektonPipelineRunOptions) SetCreateTektonPipelineRunOptions(createTektonPipelineRunOptions *CreateTektonPipelineRunOptions) *AdmitTektonPipelineRunOptions {
+	_options.CreateTektonPipelineRunOptions = createTektonPipelineRunOptions
+	return _options
+}
+
+// SetPriority : Allow user to set Priority
+func (_options *AdmitTektonPipelineRunOptions) SetPriority(priority int) *AdmitTektonPipelineRunOptions {
+	_options.Priority = priority
+	return _options
+}
+
+// PipelineRunAdmissionQueue holds back run creations until the trigger has fewer active runs than its limit, so that
+// the service does not queue or cancel them (see the `max_concurrent_runs` and `limit_waiting_runs` trigger
+// settings). Runs waiting for a slot are admitted by priority, then in submission order.
+//
+// The limit of a trigger is read once. The runs created by other clients are counted, but since the queue polls the
+// service, a slot freed by another client may be taken by a run of that client first. When the service cannot be
+// reached or answers with a 429 or 5xx status code, the queue backs off and counts again; other errors are returned to
+// the runs waiting for a slot of the trigger. A PipelineRunAdmissionQueue is safe for concurrent use, and stops
+// polling when it is closed.
+type PipelineRunAdmissionQueue struct {
+	options  *AdmitTektonPipelineRunsOptions
+	client   *CdTektonPipelineV2
+	ctx      context.Context
+	cancel   context.CancelFunc
+	mutex    sync.Mutex
+	lanes    map[pipelineRunAdmissionKey]*pipelineRunAdmissionLane
+	sequence uint64
+}
+
+// pipelineRunAdmissionKey identifies the trigger of a run.
+type pipelineRunAdmissionKey struct {
+	pipelineID  string
+	triggerName string
+}
+
+// pipelineRunAdmissionLane holds the runs waiting for a slot of a trigger.
+type pipelineRunAdmissionLane struct {
+	// The limit of the trigger; negative until it is read, zero when the trigger has no limit.
+	limit int64
+
+	// The runs waiting for a slot, in admission order.
+	waiting []*pipelineRunAdmissionTicket
+
+	// The number of admitted runs being created, which may not be listed yet.
+	creating int64
+
+	// True while a goroutine admits the waiting runs.
+	dispatching bool
+}
+
+// pipelineRunAdmissionTicket is a run waiting for a slot. Its channel receives nil when it is admitted, or the error
+// that prevented its admission.
+type pipelineRunAdmissionTicket struct {
+	priority int
+	sequence uint64
+	admitted chan error
+}
+
+// NewPipelineRunAdmissionQueue returns a new PipelineRunAdmissionQueue instance.
+func (cdTektonPipeline *CdTektonPipelineV2) NewPipelineRunAdmissionQueue(options *AdmitTektonPipelineRunsOptions) (queue *PipelineRunAdmissionQueue, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(options, "options")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy AdmitTektonPipelineRunsOptions = *options
+	if optionsCopy.PollInterval <= 0 {
+		optionsCopy.PollInterval = DefaultAdmissionPollInterval
+	}
+	queue = &PipelineRunAdmissionQueue{
+		options: &optionsCopy,
+		client:  cdTektonPipeline,
+		lanes:   make(map[pipelineRunAdmissionKey]*pipelineRunAdmissionLane),
+	}
+	queue.ctx, queue.cancel = context.WithCancel(context.Background())
+	return
+}
+
+// Close stops the queue. The runs waiting for a slot, and the runs submitted afterwards, fail with an
+// `admission-queue-closed` error; the admitted runs are still created.
+func (queue *PipelineRunAdmissionQueue) Close() {
+	queue.cancel()
+}
+
+// CreateTektonPipelineRun : Trigger a pipeline run once its trigger has a free slot
+// This waits until the trigger has fewer active runs than its limit and the runs submitted before with the same or a
+// higher priority were created, then creates the run.
+func (queue *PipelineRunAdmissionQueue) CreateTektonPipelineRun(admitTektonPipelineRunOptions *AdmitTektonPipelineRunOptions) (result *PipelineRun, response *core.DetailedResponse, err error) {
+	result, response, err = queue.CreateTektonPipelineRunWithContext(context.Background(), admitTektonPipelineRunOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateTektonPipelineRunWithContext is an alternate form of the CreateTektonPipelineRun method which supports a Context parameter.
+// When the context is done before the run is admitted, the run is withdrawn from the queue.
+func (queue *PipelineRunAdmissionQueue) CreateTektonPipelineRunWithContext(ctx context.Context, admitTektonPipelineRunOptions *AdmitTektonPipelineRunOptions) (result *PipelineRun, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(admitTektonPipelineRunOptions, "admitTektonPipelineRunOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(admitTektonPipelineRunOptions, "admitTektonPipelineRunOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	createOptions := admitTektonPipelineRunOptions.CreateTektonPipelineRunOptions
+	err = core.ValidateStruct(createOptions, "createTektonPipelineRunOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	key := pipelineRunAdmissionKey{pipelineID: *createOptions.PipelineID, triggerName: core.StringNilMapper(createOptions.TriggerName)}
+	if key.triggerName == "" && createOptions.Trigger != nil {
+		key.triggerName = core.StringNilMapper(createOptions.Trigger.Name)
+	}
+	if key.triggerName == "" {
+		err = core.SDKErrorf(nil, "the trigger of the run must be named", "admission-missing-trigger-name", common.GetComponentInfo())
+		return
+	}
+
+	ticket := queue.enqueue(key, admitTektonPipelineRunOptions.Priority)
+	select {
+	case err = <-ticket.admitted:
+		if err != nil {
+			return
+		}
+	case <-ctx.Done():
+		if !queue.withdraw(key, ticket) && <-ticket.admitted == nil {
+			queue.created(key)
+		}
+		err = core.SDKErrorf(ctx.Err(), "", "admission-cancelled", common.GetComponentInfo())
+		return
+	}
+	defer queue.created(key)
+
+	result, response, err = queue.client.CreateTektonPipelineRunWithContext(ctx, createOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "admission-create-error")
+	}
+	return
+}
+
+// Waiting returns the number of runs waiting for a slot.
+func (queue *PipelineRunAdmissionQueue) Waiting() (waiting int) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	for _, lane := range queue.lanes {
+		waiting += len(lane.waiting)
+	}
+	return
+}
+
+// enqueue adds a run to the lane of its trigger, and starts admitting the runs of the lane if needed.
+func (queue *PipelineRunAdmissionQueue) enqueue(key pipelineRunAdmissionKey, priority int) *pipelineRunAdmissionTicket {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+
+	lane := queue.lanes[key]
+	if lane == nil {
+		lane = &pipelineRunAdmissionLane{limit: -1}
+		queue.lanes[key] = lane
+	}
+	queue.sequence++
+	ticket := &pipelineRunAdmissionTicket{priority: priority, sequence: queue.sequence, admitted: make(chan error, 1)}
+	index, _ := slices.BinarySearchFunc(lane.waiting, ticket, func(waiting *pipelineRunAdmissionTicket, ticket *pipelineRunAdmissionTicket) int {
+		if waiting.priority != ticket.priority {
+			return cmp.Compare(ticket.priority, waiting.priority)
+		}
+		return cmp.Compare(waiting.sequence, ticket.sequence)
+	})
+	lane.waiting = slices.Insert(lane.waiting, index, ticket)
+
+	if !lane.dispatching {
+		lane.dispatching = true
+		go queue.dispatch(key, lane)
+	}
+	return ticket
+}
+
+// withdraw removes a run that was not admitted yet from its lane. It returns false if the run was already admitted.
+func (queue *PipelineRunAdmissionQueue) withdraw(key pipelineRunAdmissionKey, ticket *pipelineRunAdmissionTicket) bool {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	lane := queue.lanes[key]
+	index := slices.Index(lane.waiting, ticket)
+	if index < 0 {
+		return false
+	}
+	lane.waiting = slices.Delete(lane.waiting, index, index+1)
+	return true
+}
+
+// created records the end of the creation of an admitted run.
+func (queue *PipelineRunAdmissionQueue) created(key pipelineRunAdmissionKey) {
+	queue.mutex.Lock()
+	defer queue.mutex.Unlock()
+	queue.lanes[key].creating--
+}
+
+// dispatch admits the runs of a lane as slots free up, until no run waits or the queue is closed.
+func (queue *PipelineRunAdmissionQueue) dispatch(key pipelineRunAdmissionKey, lane *pipelineRunAdmissionLane) {
+	backoff := newPollBackoff(queue.options.PollInterval, 8*queue.options.PollInterval, 2, 0)
+	for {
+		queue.mutex.Lock()
+		if len(lane.waiting) == 0 {
+			lane.dispatching = false
+			queue.mutex.Unlock()
+			return
+		}
+		limit := lane.limit
+		queue.mutex.Unlock()
+
+		var err error
+		if limit < 0 {
+			limit, err = queue.triggerLimit(key)
+		}
+		var active int64
+		if err == nil && limit > 0 {
+			active, err = queue.countActiveRuns(key, limit)
+		}
+
+		queue.mutex.Lock()
+		if queue.ctx.Err() != nil {
+			err = core.SDKErrorf(queue.ctx.Err(), "the admission queue is closed", "admission-queue-closed", common.GetComponentInfo())
+		}
+		if err != nil && !isTransientError(err) {
+			// The waiting runs cannot be admitted safely.
+			for _, ticket := range lane.waiting {
+				ticket.admitted <- err
+			}
+			lane.waiting = nil
+			lane.dispatching = false
+			queue.mutex.Unlock()
+			return
+		}
+		delay := queue.options.PollInterval
+		if err != nil {
+			// The service may answer again later: count again after a growing delay.
+			delay = backoff.next()
+		} else {
+			backoff = newPollBackoff(queue.options.PollInterval, 8*queue.options.PollInterval, 2, 0)
+			lane.limit = limit
+			for len(lane.waiting) > 0 && (limit == 0 || active+lane.creating < limit) {
+				lane.waiting[0].admitted <- nil
+				lane.waiting = lane.waiting[1:]
+				lane.creating++
+			}
+			if len(lane.waiting) == 0 {
+				lane.dispatching = false
+				queue.mutex.Unlock()
+				return
+			}
+		}
+		queue.mutex.Unlock()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-queue.ctx.Done():
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// triggerLimit returns the limit of a trigger: MaxConcurrentRuns when set, otherwise the `max_concurrent_runs` of the
+// trigger.
+func (queue *PipelineRunAdmissionQueue) triggerLimit(key pipelineRunAdmissionKey) (limit int64, err error) {
+	if queue.options.MaxConcurrentRuns > 0 {
+		return queue.options.MaxConcurrentRuns, nil
+	}
+	triggers, _, err := queue.client.ListTektonPipelineTriggersWithContext(queue.ctx, &ListTektonPipelineTriggersOptions{
+		PipelineID: core.StringPtr(key.pipelineID),
+		Name:       core.StringPtr(key.triggerName),
+		Headers:    queue.options.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "admission-list-triggers-error")
+		return
+	}
+	for _, triggerIntf := range triggers.Triggers {
+		if trigger, ok := triggerIntf.(*Trigger); ok && core.StringNilMapper(trigger.Name) == key.triggerName {
+			if trigger.MaxConcurrentRuns != nil && *trigger.MaxConcurrentRuns > 0 {
+				limit = *trigger.MaxConcurrentRuns
+			}
+			return
+		}
+	}
+	err = core.SDKErrorf(nil, fmt.Sprintf("trigger '%s' not found in pipeline '%s'", key.triggerName, key.pipelineID), "admission-trigger-not-found", common.GetComponentInfo())
+	return
+}
+
+// countActiveRuns returns the number of active runs of a trigger, counting at most limit runs.
+func (queue *PipelineRunAdmissionQueue) countActiveRuns(key pipelineRunAdmissionKey, limit int64) (active int64, err error) {
+	for _, status := range pipelineRunAdmissionActiveStatuses {
+		var pager *TektonPipelineRunsPager
+		pager, err = queue.client.NewTektonPipelineRunsPager(&ListTektonPipelineRunsOptions{
+			PipelineID:  core.StringPtr(key.pipelineID),
+			TriggerName: core.StringPtr(key.triggerName),
+			Status:      core.StringPtr(string(status)),
+			Headers:     queue.options.Headers,
+		})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "admission-pager-error")
+			return
+		}
+		for pager.HasNext() && active < limit {
+			var runs []PipelineRun
+			runs, err = pager.GetNextWithContext(queue.ctx)
+			if err != nil {
+				err = core.RepurposeSDKProblem(err, "admission-list-runs-error")
+				return
+			}
+			active += int64(len(runs))
+		}
+		if active >= limit {
+			return
+		}
+	}
+	return
+}
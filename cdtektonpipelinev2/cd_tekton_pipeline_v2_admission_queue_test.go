@@ -0,0 +1,197 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CdTektonPipelineV2 PipelineRunAdmissionQueue`, func() {
+	var store *mockRunStore
+	var testServer *httptest.Server
+	var cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+
+	BeforeEach(func() {
+		store = newMockRunStore()
+		triggers := []map[string]interface{}{
+			{"type": "manual", "name": "nightly", "event_listener": "listener", "max_concurrent_runs": 2},
+			{"type": "manual", "name": "unlimited", "event_listener": "listener"},
+		}
+		store.intercept(func(res http.ResponseWriter, req *http.Request) bool {
+			if req.Method != "GET" || req.URL.Path != "/tekton_pipelines/PipelineID/triggers" {
+				return false
+			}
+			matching := []map[string]interface{}{}
+			for _, trigger := range triggers {
+				if name := req.URL.Query().Get("name"); name == "" || trigger["name"] == name {
+					matching = append(matching, trigger)
+				}
+			}
+			writeMockJSON(res, 200, map[string]interface{}{"triggers": matching})
+			return true
+		})
+		store.add("PipelineID", mockRun{ID: "run-1", Status: "running", Trigger: "nightly"})
+		store.add("PipelineID", mockRun{ID: "run-2", Status: "queued", Trigger: "nightly"})
+		store.add("PipelineID", mockRun{ID: "run-3", Status: "running", Trigger: "unlimited"})
+		testServer = store.server()
+		var serviceErr error
+		cdTektonPipelineService, serviceErr = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newAdmitOptions := func(triggerName string, description string, priority int) *cdtektonpipelinev2.AdmitTektonPipelineRunOptions {
+		createOptions := cdTektonPipelineService.NewCreateTektonPipelineRunOptions("PipelineID").
+			SetTriggerName(triggerName).
+			SetDescription(description)
+		return cdTektonPipelineService.NewAdmitTektonPipelineRunOptions(createOptions).SetPriority(priority)
+	}
+	createdRun := func(description string) string {
+		store.mutex.Lock()
+		defer store.mutex.Unlock()
+		for _, run := range store.runs["PipelineID"] {
+			if run.Description == description {
+				return run.ID
+			}
+		}
+		return ""
+	}
+
+	It(`Invoke CreateTektonPipelineRun admits the runs by priority as slots free up`, func() {
+		queue, err := cdTektonPipelineService.NewPipelineRunAdmissionQueue(
+			cdTektonPipelineService.NewAdmitTektonPipelineRunsOptions().SetPollInterval(time.Millisecond))
+		Expect(err).To(BeNil())
+
+		errs := make(chan error, 3)
+		submit := func(description string, priority int) {
+			go func() {
+				_, _, err := queue.CreateTektonPipelineRun(newAdmitOptions("nightly", description, priority))
+				errs <- err
+			}()
+		}
+		submit("first", 0)
+		Eventually(queue.Waiting).Should(Equal(1))
+		submit("second", 0)
+		Eventually(queue.Waiting).Should(Equal(2))
+		submit("urgent", 5)
+		Eventually(queue.Waiting).Should(Equal(3))
+		Consistently(queue.Waiting, 20*time.Millisecond).Should(Equal(3))
+
+		store.setStatus("run-1", "succeeded")
+		Eventually(func() string { return createdRun("urgent") }).Should(Equal("new-1"))
+		Consistently(queue.Waiting, 20*time.Millisecond).Should(Equal(2))
+
+		store.setStatus("run-2", "cancelled")
+		Eventually(func() string { return createdRun("first") }).Should(Equal("new-2"))
+		store.setStatus("new-1", "failed")
+		Eventually(func() string { return createdRun("second") }).Should(Equal("new-3"))
+		for range 3 {
+			Expect(<-errs).To(BeNil())
+		}
+		Expect(queue.Waiting()).To(Equal(0))
+	})
+	It(`Invoke CreateTektonPipelineRun creates the runs of triggers without limit right away`, func() {
+		queue, err := cdTektonPipelineService.NewPipelineRunAdmissionQueue(cdTektonPipelineService.NewAdmitTektonPipelineRunsOptions())
+		Expect(err).To(BeNil())
+		run, _, err := queue.CreateTektonPipelineRun(newAdmitOptions("unlimited", "now", 0))
+		Expect(err).To(BeNil())
+		Expect(*run.ID).To(Equal("new-1"))
+		Expect(store.requestLog()).To(Equal([]string{
+			"GET /tekton_pipelines/PipelineID/triggers",
+			"POST /tekton_pipelines/PipelineID/pipeline_runs",
+		}))
+	})
+	It(`Invoke CreateTektonPipelineRunWithContext withdraws the run when the context is done`, func() {
+		queue, err := cdTektonPipelineService.NewPipelineRunAdmissionQueue(
+			cdTektonPipelineService.NewAdmitTektonPipelineRunsOptions().SetMaxConcurrentRuns(1).SetPollInterval(time.Millisecond))
+		Expect(err).To(BeNil())
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		_, _, err = queue.CreateTektonPipelineRunWithContext(ctx, newAdmitOptions("unlimited", "late", 0))
+		Expect(err).ToNot(BeNil())
+		Expect(queue.Waiting()).To(Equal(0))
+		Expect(store.requestLog()).ToNot(ContainElement("POST /tekton_pipelines/PipelineID/pipeline_runs"))
+	})
+	It(`Invoke CreateTektonPipelineRun counts again after a transient error`, func() {
+		queue, err := cdTektonPipelineService.NewPipelineRunAdmissionQueue(
+			cdTektonPipelineService.NewAdmitTektonPipelineRunsOptions().SetPollInterval(time.Millisecond))
+		Expect(err).To(BeNil())
+		defer queue.Close()
+		store.setStatus("run-1", "succeeded")
+		failLists := 2
+		store.intercept(func(res http.ResponseWriter, req *http.Request) bool {
+			if failLists == 0 || req.Method != "GET" || req.URL.Path != "/tekton_pipelines/PipelineID/pipeline_runs" {
+				return false
+			}
+			failLists--
+			writeMockError(res, 503, "unavailable")
+			return true
+		})
+		run, _, err := queue.CreateTektonPipelineRun(newAdmitOptions("nightly", "retried", 0))
+		Expect(err).To(BeNil())
+		Expect(*run.ID).To(Equal("new-1"))
+	})
+	It(`Invoke Close fails the runs waiting for a slot`, func() {
+		queue, err := cdTektonPipelineService.NewPipelineRunAdmissionQueue(
+			cdTektonPipelineService.NewAdmitTektonPipelineRunsOptions().SetPollInterval(time.Hour))
+		Expect(err).To(BeNil())
+		errs := make(chan error, 1)
+		go func() {
+			_, _, err := queue.CreateTektonPipelineRun(newAdmitOptions("nightly", "held", 0))
+			errs <- err
+		}()
+		Eventually(queue.Waiting).Should(Equal(1))
+		queue.Close()
+		Eventually(errs).Should(Receive(MatchError(ContainSubstring("the admission queue is closed"))))
+		Expect(queue.Waiting()).To(Equal(0))
+
+		_, _, err = queue.CreateTektonPipelineRun(newAdmitOptions("unlimited", "late", 0))
+		Expect(err).ToNot(BeNil())
+		Expect(createdRun("held")).To(Equal(""))
+		Expect(createdRun("late")).To(Equal(""))
+	})
+	It(`Invoke CreateTektonPipelineRun with error: Operation validation and request error`, func() {
+		_, err := cdTektonPipelineService.NewPipelineRunAdmissionQueue(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.NewPipelineRunAdmissionQueue(cdTektonPipelineService.NewAdmitTektonPipelineRunsOptions().SetMaxConcurrentRuns(-1))
+		Expect(err).ToNot(BeNil())
+
+		queue, err := cdTektonPipelineService.NewPipelineRunAdmissionQueue(cdTektonPipelineService.NewAdmitTektonPipelineRunsOptions())
+		Expect(err).To(BeNil())
+		_, _, err = queue.CreateTektonPipelineRun(nil)
+		Expect(err).ToNot(BeNil())
+		_, _, err = queue.CreateTektonPipelineRun(cdTektonPipelineService.NewAdmitTektonPipelineRunOptions(
+			cdTektonPipelineService.NewCreateTektonPipelineRunOptions("PipelineID")))
+		Expect(err).ToNot(BeNil())
+		_, _, err = queue.CreateTektonPipelineRun(newAdmitOptions("missing", "never", 0))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("trigger 'missing' not found"))
+	})
+})
@@ -0,0 +1,303 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// buildNumberAttempts is the number of times the build numbers of a group of pipelines are aligned before giving up,
+// when runs keep starting while they are updated.
+const buildNumberAttempts = 3
+
+// buildNumberLocks serialize the build number updates of a group of pipelines in this process, since the service
+// cannot update the next build number conditionally. They are keyed by the sorted IDs of the pipelines of the group,
+// and removed once no call uses them.
+var buildNumberLocks = struct {
+	sync.Mutex
+	groups map[string]*buildNumberLock
+}{groups: make(map[string]*buildNumberLock)}
+
+// buildNumberLock is the lock of a group of pipelines, with the number of calls holding or waiting for it.
+type buildNumberLock struct {
+	sync.Mutex
+	users int
+}
+
+// SyncTektonPipelineBuildNumbersOptions : The SyncTektonPipelineBuildNumbers and ReserveTektonPipelineBuildNumber
+// options.
+type SyncTektonPipelineBuildNumbersOptions struct {
+	// The IDs of the Tekton pipelines sharing a build number counter.
+	PipelineIDs []string `json:"pipeline_ids" validate:"required,min=1,dive,ne="`
+
+	// When true, the build numbers are computed without updating the pipelines. Only used by
+	// SyncTektonPipelineBuildNumbers.
+	DryRun bool `json:"dry_run,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewSyncTektonPipelineBuildNumbersOptions : Instantiate SyncTektonPipelineBuildNumbersOptions
+func (*CdTektonPipelineV2) NewSyncTektonPipelineBuildNumbersOptions(pipelineIDs []string) *SyncTektonPipelineBuildNumbersOptions {
+	return &SyncTektonPipelineBuildNumbersOptions{
+		PipelineIDs: pipelineIDs,
+	}
+}
+
+// SetPipelineIDs : Allow user to set PipelineIDs
+func (_options *SyncTektonPipelineBuildNumbersOptions) SetPipelineIDs(pipelineIDs []string) *SyncTektonPipelineBuildNumbersOptions {
+	_options.PipelineIDs = pipelineIDs
+	return _options
+}
+
+// SetDryRun : Allow user to set DryRun
+func (_options *SyncTektonPipelineBuildNumbersOptions) SetDryRun(dryRun bool) *SyncTektonPipelineBuildNumbersOptions {
+	_options.DryRun = dryRun
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *SyncTektonPipelineBuildNumbersOptions) SetHeaders(param map[string]string) *SyncTektonPipelineBuildNumbersOptions {
+	options.Headers = param
+	return options
+}
+
+// PipelineBuildNumberReport : The build numbers of a group of pipelines aligned by SyncTektonPipelineBuildNumbers or
+// ReserveTektonPipelineBuildNumber.
+type PipelineBuildNumberReport struct {
+	// The next build number of every pipeline for SyncTektonPipelineBuildNumbers, or the reserved build number for
+	// ReserveTektonPipelineBuildNumber.
+	BuildNumber int64
+
+	// The pipelines, in the order of the options.
+	Pipelines []PipelineBuildNumberUpdate
+}
+
+// PipelineBuildNumberUpdate : The build numbers of a pipeline of the group.
+type PipelineBuildNumberUpdate struct {
+	PipelineID string
+
+	// The latest build number of the pipeline.
+	BuildNumber int64
+
+	// The next build number of the pipeline, before and after the update.
+	PreviousNextBuildNumber int64
+	NextBuildNumber         int64
+
+	// True when the pipeline was updated.
+	Updated bool
+}
+
+// SyncTektonPipelineBuildNumbers : Align the next build number of a group of pipelines
+// This sets the next build number of every pipeline of the group to the smallest number that none of them used or is
+// about to use: max(`build_number` + 1, `next_build_number`) across the group. Afterwards, the pipelines share one
+// increasing build number counter as long as they are synchronized again after each run.
+func (cdTektonPipeline *CdTektonPipelineV2) SyncTektonPipelineBuildNumbers(syncTektonPipelineBuildNumbersOptions *SyncTektonPipelineBuildNumbersOptions) (result *PipelineBuildNumberReport, err error) {
+	result, err = cdTektonPipeline.SyncTektonPipelineBuildNumbersWithContext(context.Background(), syncTektonPipelineBuildNumbersOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// SyncTektonPipelineBuildNumbersWithContext is an alternate form of the SyncTektonPipelineBuildNumbers method which supports a Context parameter.
+func (cdTektonPipeline *CdTektonPipelineV2) SyncTektonPipelineBuildNumbersWithContext(ctx context.Context, syncTektonPipelineBuildNumbersOptions *SyncTektonPipelineBuildNumbersOptions) (result *PipelineBuildNumberReport, err error) {
+	err = core.ValidateNotNil(syncTektonPipelineBuildNumbersOptions, "syncTektonPipelineBuildNumbersOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(syncTektonPipelineBuildNumbersOptions, "syncTektonPipelineBuildNumbersOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	defer lockBuildNumbers(syncTektonPipelineBuildNumbersOptions.PipelineIDs)()
+	return cdTektonPipeline.alignBuildNumbers(ctx, syncTektonPipelineBuildNumbersOptions, false)
+}
+
+// ReserveTektonPipelineBuildNumber : Reserve the next build number of a group of pipelines
+// This returns the build number that SyncTektonPipelineBuildNumbers would use, and sets the next build number of every
+// pipeline of the group past it, so that no run of the group gets it. The caller can then use it, for example as the
+// version of an artifact published outside of the pipelines.
+//
+// The service cannot update the next build number conditionally, so the reservations are only exclusive within this
+// process. Concurrent reservations by other clients of the same group must be serialized by the callers.
+func (cdTektonPipeline *CdTektonPipelineV2) ReserveTektonPipelineBuildNumber(syncTektonPipelineBuildNumbersOptions *SyncTektonPipelineBuildNumbersOptions) (result *PipelineBuildNumberReport, err error) {
+	result, err = cdTektonPipeline.ReserveTektonPipelineBuildNumberWithContext(context.Background(), syncTektonPipelineBuildNumbersOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ReserveTektonPipelineBuildNumberWithContext is an alternate form of the ReserveTektonPipelineBuildNumber method which supports a Context parameter.
+func (cdTektonPipeline *CdTektonPipelineV2) ReserveTektonPipelineBuildNumberWithContext(ctx context.Context, syncTektonPipelineBuildNumbersOptions *SyncTektonPipelineBuildNumbersOptions) (result *PipelineBuildNumberReport, err error) {
+	err = core.ValidateNotNil(syncTektonPipelineBuildNumbersOptions, "syncTektonPipelineBuildNumbersOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(syncTektonPipelineBuildNumbersOptions, "syncTektonPipelineBuildNumbersOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	defer lockBuildNumbers(syncTektonPipelineBuildNumbersOptions.PipelineIDs)()
+	return cdTektonPipeline.alignBuildNumbers(ctx, syncTektonPipelineBuildNumbersOptions, true)
+}
+
+// lockBuildNumbers locks the build numbers of a group of pipelines, and returns the function unlocking them.
+func lockBuildNumbers(pipelineIDs []string) func() {
+	key := strings.Join(slices.Compact(slices.Sorted(slices.Values(pipelineIDs))), "\n")
+	buildNumberLocks.Lock()
+	lock := buildNumberLocks.groups[key]
+	if lock == nil {
+		lock = &buildNumberLock{}
+		buildNumberLocks.groups[key] = lock
+	}
+	lock.users++
+	buildNumberLocks.Unlock()
+
+	lock.Lock()
+	return func() {
+		lock.Unlock()
+		buildNumberLocks.Lock()
+		defer buildNumberLocks.Unlock()
+		lock.users--
+		if lock.users == 0 {
+			delete(buildNumberLocks.groups, key)
+		}
+	}
+}
+
+// alignBuildNumbers sets the next build number of the pipelines to the next build number of the group, plus one when
+// reserving it. Since runs may start while the pipelines are updated, the build numbers are read again afterwards, and
+// the alignment is retried when a run used a number at or past the aligned one. When reserving, only a run that used
+// the reserved number conflicts: the runs started after the update use the numbers past it.
+func (cdTektonPipeline *CdTektonPipelineV2) alignBuildNumbers(ctx context.Context, options *SyncTektonPipelineBuildNumbersOptions, reserve bool) (result *PipelineBuildNumberReport, err error) {
+	for attempt := 1; ; attempt++ {
+		var pipelines []*TektonPipeline
+		pipelines, err = cdTektonPipeline.getBuildNumberPipelines(ctx, options)
+		if err != nil {
+			return
+		}
+
+		result = &PipelineBuildNumberReport{}
+		for _, pipeline := range pipelines {
+			buildNumber, nextBuildNumber := pipelineBuildNumbers(pipeline)
+			result.BuildNumber = max(result.BuildNumber, buildNumber+1, nextBuildNumber)
+		}
+		nextBuildNumber := result.BuildNumber
+		if reserve {
+			nextBuildNumber++
+		}
+
+		for index, pipeline := range pipelines {
+			buildNumber, previous := pipelineBuildNumbers(pipeline)
+			update := PipelineBuildNumberUpdate{
+				PipelineID:              options.PipelineIDs[index],
+				BuildNumber:             buildNumber,
+				PreviousNextBuildNumber: previous,
+				NextBuildNumber:         previous,
+			}
+			if previous != nextBuildNumber && (reserve || !options.DryRun) {
+				var patch map[string]interface{}
+				patch, err = (&TektonPipelinePatch{NextBuildNumber: core.Int64Ptr(nextBuildNumber)}).AsPatch()
+				if err != nil {
+					err = core.SDKErrorf(err, "", "build-number-patch-error", common.GetComponentInfo())
+					return
+				}
+				_, _, err = cdTektonPipeline.UpdateTektonPipelineWithContext(ctx, &UpdateTektonPipelineOptions{
+					ID:                  core.StringPtr(update.PipelineID),
+					TektonPipelinePatch: patch,
+					Headers:             options.Headers,
+				})
+				if err != nil {
+					err = core.RepurposeSDKProblem(err, "build-number-update-error")
+					return
+				}
+				update.Updated = true
+			}
+			if previous != nextBuildNumber {
+				update.NextBuildNumber = nextBuildNumber
+			}
+			result.Pipelines = append(result.Pipelines, update)
+		}
+		if options.DryRun && !reserve {
+			return
+		}
+
+		// Check that no run started during the update used the aligned build number, which is also the reserved one:
+		// the other pipelines would use it again.
+		pipelines, err = cdTektonPipeline.getBuildNumberPipelines(ctx, options)
+		if err != nil {
+			return
+		}
+		conflict := ""
+		for index, pipeline := range pipelines {
+			buildNumber, _ := pipelineBuildNumbers(pipeline)
+			result.Pipelines[index].BuildNumber = buildNumber
+			if buildNumber == result.BuildNumber || (buildNumber > result.BuildNumber && !reserve) {
+				conflict = options.PipelineIDs[index]
+			}
+		}
+		if conflict == "" {
+			return
+		}
+		if attempt == buildNumberAttempts {
+			err = core.SDKErrorf(nil, fmt.Sprintf("pipeline '%s' kept starting runs while its build number was updated", conflict), "build-number-conflict", common.GetComponentInfo())
+			return
+		}
+	}
+}
+
+// getBuildNumberPipelines gets the pipelines of the group.
+func (cdTektonPipeline *CdTektonPipelineV2) getBuildNumberPipelines(ctx context.Context, options *SyncTektonPipelineBuildNumbersOptions) (pipelines []*TektonPipeline, err error) {
+	for _, pipelineID := range options.PipelineIDs {
+		var pipeline *TektonPipeline
+		pipeline, _, err = cdTektonPipeline.GetTektonPipelineWithContext(ctx, &GetTektonPipelineOptions{
+			ID:      core.StringPtr(pipelineID),
+			Headers: options.Headers,
+		})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "build-number-get-pipeline-error")
+			return
+		}
+		pipelines = append(pipelines, pipeline)
+	}
+	return
+}
+
+// pipelineBuildNumbers returns the latest and next build numbers of a pipeline. A pipeline without runs has a latest
+// build number of 0, and the next build number defaults to the one after the latest.
+func pipelineBuildNumbers(pipeline *TektonPipeline) (buildNumber int64, nextBuildNumber int64) {
+	if pipeline.BuildNumber != nil {
+		buildNumber = *pipeline.BuildNumber
+	}
+	nextBuildNumber = buildNumber + 1
+	if pipeline.NextBuildNumber != nil {
+		nextBuildNumber = *pipeline.NextBuildNumber
+	}
+	return
+}
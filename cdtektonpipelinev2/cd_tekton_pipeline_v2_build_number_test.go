@@ -0,0 +1,168 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CdTektonPipelineV2 pipeline build numbers`, func() {
+	// buildNumbers holds the latest and next build number of each pipeline; a next build number of zero is omitted.
+	type buildNumbers struct{ build, next int64 }
+	var mutex sync.Mutex
+	var pipelines map[string]*buildNumbers
+	var patches []string
+	// Pipelines that start a run when they are first patched, before the patch is applied.
+	var startRunOnPatch map[string]bool
+	// Pipelines that start a run when they are first patched, after the patch is applied.
+	var startRunAfterPatch map[string]bool
+	var testServer *httptest.Server
+	var cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+
+	BeforeEach(func() {
+		pipelines = map[string]*buildNumbers{"a": {4, 5}, "b": {7, 8}, "c": {0, 0}}
+		patches = nil
+		startRunOnPatch = map[string]bool{}
+		startRunAfterPatch = map[string]bool{}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			id := strings.TrimPrefix(req.URL.Path, "/tekton_pipelines/")
+			pipeline := pipelines[id]
+			if pipeline == nil {
+				res.WriteHeader(404)
+				return
+			}
+			if req.Method == "PATCH" {
+				var patch map[string]int64
+				Expect(json.NewDecoder(req.Body).Decode(&patch)).To(Succeed())
+				if startRunOnPatch[id] {
+					delete(startRunOnPatch, id)
+					pipeline.build = max(pipeline.next, pipeline.build+1)
+					pipeline.next = pipeline.build + 1
+				}
+				pipeline.next = patch["next_build_number"]
+				if startRunAfterPatch[id] {
+					delete(startRunAfterPatch, id)
+					pipeline.build = pipeline.next
+					pipeline.next = pipeline.build + 1
+				}
+				patches = append(patches, id)
+			}
+			body := map[string]interface{}{"id": id, "status": "configured", "build_number": pipeline.build}
+			if pipeline.next != 0 {
+				body["next_build_number"] = pipeline.next
+			}
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			Expect(json.NewEncoder(res).Encode(body)).To(Succeed())
+		}))
+		var serviceErr error
+		cdTektonPipelineService, serviceErr = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Invoke SyncTektonPipelineBuildNumbers successfully`, func() {
+		options := cdTektonPipelineService.NewSyncTektonPipelineBuildNumbersOptions([]string{"a", "b", "c"})
+		result, err := cdTektonPipelineService.SyncTektonPipelineBuildNumbers(options.SetDryRun(true))
+		Expect(err).To(BeNil())
+		Expect(result.BuildNumber).To(Equal(int64(8)))
+		Expect(patches).To(BeEmpty())
+
+		result, err = cdTektonPipelineService.SyncTektonPipelineBuildNumbers(options.SetDryRun(false))
+		Expect(err).To(BeNil())
+		Expect(result.BuildNumber).To(Equal(int64(8)))
+		Expect(result.Pipelines).To(Equal([]cdtektonpipelinev2.PipelineBuildNumberUpdate{
+			{PipelineID: "a", BuildNumber: 4, PreviousNextBuildNumber: 5, NextBuildNumber: 8, Updated: true},
+			{PipelineID: "b", BuildNumber: 7, PreviousNextBuildNumber: 8, NextBuildNumber: 8},
+			{PipelineID: "c", BuildNumber: 0, PreviousNextBuildNumber: 1, NextBuildNumber: 8, Updated: true},
+		}))
+		Expect(patches).To(Equal([]string{"a", "c"}))
+		Expect(pipelines["c"].next).To(Equal(int64(8)))
+	})
+	It(`Invoke SyncTektonPipelineBuildNumbers when a run starts after the update`, func() {
+		startRunAfterPatch["a"] = true
+		result, err := cdTektonPipelineService.SyncTektonPipelineBuildNumbers(
+			cdTektonPipelineService.NewSyncTektonPipelineBuildNumbersOptions([]string{"a", "b", "c"}))
+		Expect(err).To(BeNil())
+		Expect(result.BuildNumber).To(Equal(int64(9)))
+		Expect(patches).To(Equal([]string{"a", "c", "b", "c"}))
+		Expect(pipelines["a"]).To(Equal(&buildNumbers{8, 9}))
+		Expect(pipelines["b"].next).To(Equal(int64(9)))
+		Expect(pipelines["c"].next).To(Equal(int64(9)))
+	})
+	It(`Invoke ReserveTektonPipelineBuildNumber successfully`, func() {
+		result, err := cdTektonPipelineService.ReserveTektonPipelineBuildNumber(
+			cdTektonPipelineService.NewSyncTektonPipelineBuildNumbersOptions([]string{"a", "b", "c"}))
+		Expect(err).To(BeNil())
+		Expect(result.BuildNumber).To(Equal(int64(8)))
+		Expect(patches).To(Equal([]string{"a", "b", "c"}))
+		for _, pipeline := range pipelines {
+			Expect(pipeline.next).To(Equal(int64(9)))
+		}
+
+		result, err = cdTektonPipelineService.ReserveTektonPipelineBuildNumber(
+			cdTektonPipelineService.NewSyncTektonPipelineBuildNumbersOptions([]string{"a", "b", "c"}))
+		Expect(err).To(BeNil())
+		Expect(result.BuildNumber).To(Equal(int64(9)))
+	})
+	It(`Invoke ReserveTektonPipelineBuildNumber when a run starts during the update`, func() {
+		startRunOnPatch["b"] = true
+		result, err := cdTektonPipelineService.ReserveTektonPipelineBuildNumber(
+			cdTektonPipelineService.NewSyncTektonPipelineBuildNumbersOptions([]string{"a", "b", "c"}))
+		Expect(err).To(BeNil())
+		Expect(result.BuildNumber).To(Equal(int64(9)))
+		Expect(pipelines["b"]).To(Equal(&buildNumbers{8, 10}))
+		Expect(result.Pipelines[1].BuildNumber).To(Equal(int64(8)))
+	})
+	It(`Invoke ReserveTektonPipelineBuildNumber when a run starts after the update`, func() {
+		startRunAfterPatch["a"] = true
+		result, err := cdTektonPipelineService.ReserveTektonPipelineBuildNumber(
+			cdTektonPipelineService.NewSyncTektonPipelineBuildNumbersOptions([]string{"a", "b", "c"}))
+		Expect(err).To(BeNil())
+		Expect(result.BuildNumber).To(Equal(int64(8)))
+		Expect(patches).To(Equal([]string{"a", "b", "c"}))
+		Expect(pipelines["a"]).To(Equal(&buildNumbers{9, 10}))
+		Expect(result.Pipelines[0].BuildNumber).To(Equal(int64(9)))
+	})
+	It(`Invoke SyncTektonPipelineBuildNumbers with error: Operation validation and request error`, func() {
+		_, err := cdTektonPipelineService.SyncTektonPipelineBuildNumbers(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.ReserveTektonPipelineBuildNumber(cdTektonPipelineService.NewSyncTektonPipelineBuildNumbersOptions(nil))
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.SyncTektonPipelineBuildNumbers(cdTektonPipelineService.NewSyncTektonPipelineBuildNumbersOptions([]string{"a", "missing"}))
+		Expect(err).ToNot(BeNil())
+		Expect(patches).To(BeEmpty())
+	})
+})
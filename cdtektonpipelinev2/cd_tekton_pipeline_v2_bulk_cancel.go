@@ -0,0 +1,303 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+)
+
+// DefaultBulkCancelConcurrency is the number of runs cancelled in parallel by CancelTektonPipelineRuns when
+// Concurrency is not set.
+const DefaultBulkCancelConcurrency = 4
+
+// CancelTektonPipelineRunsOptions : The CancelTektonPipelineRuns options.
+type CancelTektonPipelineRunsOptions struct {
+	// The IDs of the Tekton pipelines whose runs are cancelled.
+	PipelineIDs []string `json:"pipeline_ids" validate:"required,min=1,dive,ne="`
+
+	// Only cancel runs in one of these statuses. They must be active statuses (`queued`, `pending`, `running` or
+	// `waiting`); all of them are used when empty.
+	Statuses []string `json:"statuses,omitempty"`
+
+	// Only cancel runs started by this trigger.
+	TriggerName *string `json:"trigger_name,omitempty"`
+
+	// Only cancel runs created before this time.
+	CreatedBefore *strfmt.DateTime `json:"created_before,omitempty"`
+
+	// Flag indicating whether the cancellations are forced or not.
+	Force *bool `json:"force,omitempty"`
+
+	// When true, the matching runs are reported without being cancelled.
+	DryRun bool `json:"dry_run,omitempty"`
+
+	// Maximum number of runs cancelled in parallel. Defaults to DefaultBulkCancelConcurrency.
+	Concurrency int
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewCancelTektonPipelineRunsOptions : Instantiate CancelTektonPipelineRunsOptions
+func (*CdTektonPipelineV2) NewCancelTektonPipelineRunsOptions(pipelineIDs []string) *CancelTektonPipelineRunsOptions {
+	return &CancelTektonPipelineRunsOptions{
+		PipelineIDs: pipelineIDs,
+	}
+}
+
+// SetPipelineIDs : Allow user to set PipelineIDs
+func (_options *CancelTektonPipelineRunsOptions) SetPipelineIDs(pipelineIDs []string) *CancelTektonPipelineRunsOptions {
+	_options.PipelineIDs = pipelineIDs
+	return _options
+}
+
+// SetStatuses : Allow user to set Statuses
+func (_options *CancelTektonPipelineRunsOptions) SetStatuses(statuses []string) *CancelTektonPipelineRunsOptions {
+	_options.Statuses = statuses
+	return _options
+}
+
+// SetTriggerName : Allow user to set TriggerName
+func (_options *CancelTektonPipelineRunsOptions) SetTriggerName(triggerName string) *CancelTektonPipelineRunsOptions {
+	_options.TriggerName = core.StringPtr(triggerName)
+	return _options
+}
+
+// SetCreatedBefore : Allow user to set CreatedBefore
+func (_options *CancelTektonPipelineRunsOptions) SetCreatedBefore(createdBefore *strfmt.DateTime) *CancelTektonPipelineRunsOptions {
+	_options.CreatedBefore = createdBefore
+	return _options
+}
+
+// SetForce : Allow user to set Force
+func (_options *CancelTektonPipelineRunsOptions) SetForce(force bool) *CancelTektonPipelineRunsOptions {
+	_options.Force = core.BoolPtr(force)
+	return _options
+}
+
+// SetDryRun : Allow user to set DryRun
+func (_options *CancelTektonPipelineRunsOptions) SetDryRun(dryRun bool) *CancelTektonPipelineRunsOptions {
+	_options.DryRun = dryRun
+	return _options
+}
+
+// SetConcurrency : Allow user to set Concurrency
+func (_options *CancelTektonPipelineRunsOptions) SetConcurrency(concurrency int) *CancelTektonPipelineRunsOptions {
+	_options.Concurrency = concurrency
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *CancelTektonPipelineRunsOptions) SetHeaders(param map[string]string) *CancelTektonPipelineRunsOptions {
+	options.Headers = param
+	return options
+}
+
+// PipelineRunCancelReport : The result of CancelTektonPipelineRuns.
+type PipelineRunCancelReport struct {
+	// Whether the runs were only listed.
+	DryRun bool `json:"dry_run"`
+
+	// One result per matching run, ordered by pipeline as requested and then from newest to oldest run.
+	Results []PipelineRunCancelResult `json:"results"`
+}
+
+// PipelineRunCancelResult : The result of cancelling a single pipeline run.
+type PipelineRunCancelResult struct {
+	// The Tekton pipeline ID.
+	PipelineID string `json:"pipeline_id"`
+
+	// The matching pipeline run, as listed before being cancelled.
+	Run *PipelineRun `json:"run"`
+
+	// Whether the cancellation was accepted by the service. Always false in dry-run mode.
+	Cancelled bool `json:"cancelled"`
+
+	// The error returned when cancelling the run, if any.
+	Error error `json:"-"`
+}
+
+// Failed returns the results of the runs that could not be cancelled.
+func (report *PipelineRunCancelReport) Failed() (failed []PipelineRunCancelResult) {
+	for _, result := range report.Results {
+		if result.Error != nil {
+			failed = append(failed, result)
+		}
+	}
+	return
+}
+
+// CancelTektonPipelineRuns : Cancel the pipeline runs matching a filter
+// This lists the active runs of the specified pipelines that match the status, trigger and creation time filters and
+// cancels them with bounded parallelism. Failures to cancel individual runs are reported in the result rather than
+// returned as an error.
+func (cdTektonPipeline *CdTektonPipelineV2) CancelTektonPipelineRuns(cancelTektonPipelineRunsOptions *CancelTektonPipelineRunsOptions) (result *PipelineRunCancelReport, err error) {
+	result, err = cdTektonPipeline.CancelTektonPipelineRunsWithContext(context.Background(), cancelTektonPipelineRunsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CancelTektonPipelineRunsWithContext is an alternate form of the CancelTektonPipelineRuns method which supports a Context parameter.
+func (cdTektonPipeline *CdTektonPipelineV2) CancelTektonPipelineRunsWithContext(ctx context.Context, cancelTektonPipelineRunsOptions *CancelTektonPipelineRunsOptions) (result *PipelineRunCancelReport, err error) {
+	err = core.ValidateNotNil(cancelTektonPipelineRunsOptions, "cancelTektonPipelineRunsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(cancelTektonPipelineRunsOptions, "cancelTektonPipelineRunsOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	statuses := cancelTektonPipelineRunsOptions.Statuses
+	if len(statuses) == 0 {
+		statuses = []string{PipelineRunStatusWaitingConst, PipelineRunStatusQueuedConst, PipelineRunStatusPendingConst,
+			PipelineRunStatusRunningConst}
+	}
+	for _, status := range statuses {
+		if !ParseRunStatus(status).IsActive() {
+			err = core.SDKErrorf(nil, fmt.Sprintf("cannot cancel runs in status '%s'", status), "bulk-cancel-invalid-status", common.GetComponentInfo())
+			return
+		}
+	}
+
+	result = &PipelineRunCancelReport{DryRun: cancelTektonPipelineRunsOptions.DryRun}
+	for _, pipelineID := range cancelTektonPipelineRunsOptions.PipelineIDs {
+		var runs []PipelineRun
+		runs, err = cdTektonPipeline.listRunsToCancel(ctx, pipelineID, statuses, cancelTektonPipelineRunsOptions)
+		if err != nil {
+			result = nil
+			return
+		}
+		for index := range runs {
+			result.Results = append(result.Results, PipelineRunCancelResult{PipelineID: pipelineID, Run: &runs[index]})
+		}
+	}
+
+	if !cancelTektonPipelineRunsOptions.DryRun {
+		cdTektonPipeline.cancelRuns(ctx, result.Results, cancelTektonPipelineRunsOptions)
+	}
+	return
+}
+
+// listRunsToCancel lists the runs of a pipeline matching the filters, from newest to oldest.
+func (cdTektonPipeline *CdTektonPipelineV2) listRunsToCancel(ctx context.Context, pipelineID string, statuses []string, options *CancelTektonPipelineRunsOptions) (runs []PipelineRun, err error) {
+	// A run changing status between two listings may be listed twice.
+	listed := make(map[string]bool)
+	for _, status := range statuses {
+		var pager *TektonPipelineRunsPager
+		pager, err = cdTektonPipeline.NewTektonPipelineRunsPager(&ListTektonPipelineRunsOptions{
+			PipelineID:  core.StringPtr(pipelineID),
+			Status:      core.StringPtr(status),
+			TriggerName: options.TriggerName,
+			Headers:     options.Headers,
+		})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "bulk-cancel-pager-error")
+			return
+		}
+		for pager.HasNext() {
+			var page []PipelineRun
+			page, err = pager.GetNextWithContext(ctx)
+			if err != nil {
+				err = core.RepurposeSDKProblem(err, "bulk-cancel-list-runs-error")
+				return
+			}
+			for _, run := range page {
+				if options.CreatedBefore != nil {
+					createdAt := pipelineRunCreatedAt(&run)
+					if createdAt == nil || !createdAt.Before(time.Time(*options.CreatedBefore)) {
+						continue
+					}
+				}
+				if run.ID != nil && listed[*run.ID] {
+					continue
+				}
+				if run.ID != nil {
+					listed[*run.ID] = true
+				}
+				runs = append(runs, run)
+			}
+		}
+	}
+
+	// Merge the per-status listings back into a single newest-first listing.
+	sortPipelineRunsNewestFirst(runs)
+	return
+}
+
+// cancelRuns cancels the runs of the results with bounded concurrency, recording the outcome in each result.
+func (cdTektonPipeline *CdTektonPipelineV2) cancelRuns(ctx context.Context, results []PipelineRunCancelResult, options *CancelTektonPipelineRunsOptions) {
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultBulkCancelConcurrency
+	}
+
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, concurrency)
+	for index := range results {
+		wg.Add(1)
+		go func(result *PipelineRunCancelResult) {
+			defer wg.Done()
+			select {
+			case semaphore <- struct{}{}:
+				defer func() { <-semaphore }()
+			case <-ctx.Done():
+				result.Error = core.SDKErrorf(ctx.Err(), "", "bulk-cancel-cancelled", common.GetComponentInfo())
+				return
+			}
+
+			_, _, cancelErr := cdTektonPipeline.CancelTektonPipelineRunWithContext(ctx, &CancelTektonPipelineRunOptions{
+				PipelineID: core.StringPtr(result.PipelineID),
+				ID:         result.Run.ID,
+				Force:      options.Force,
+				Headers:    options.Headers,
+			})
+			if cancelErr != nil {
+				result.Error = core.RepurposeSDKProblem(cancelErr, "bulk-cancel-error")
+				return
+			}
+			result.Cancelled = true
+		}(&results[index])
+	}
+	wg.Wait()
+}
+
+// sortPipelineRunsNewestFirst sorts runs by decreasing creation time, keeping runs without a creation time last.
+func sortPipelineRunsNewestFirst(runs []PipelineRun) {
+	slices.SortStableFunc(runs, func(a PipelineRun, b PipelineRun) int {
+		createdA, createdB := pipelineRunCreatedAt(&a), pipelineRunCreatedAt(&b)
+		switch {
+		case createdA == nil && createdB == nil:
+			return 0
+		case createdA == nil:
+			return 1
+		case createdB == nil:
+			return -1
+		}
+		return createdB.Compare(*createdA)
+	})
+}
@@ -0,0 +1,124 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CdTektonPipelineV2 CancelTektonPipelineRuns`, func() {
+	var store *mockRunStore
+	var testServer *httptest.Server
+	var cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+
+	BeforeEach(func() {
+		store = newMockRunStore()
+		store.add("pipeline-a", mockRun{ID: "a1", Status: "succeeded", Trigger: "push"})
+		store.add("pipeline-a", mockRun{ID: "a2", Status: "running", Trigger: "push"})
+		store.add("pipeline-a", mockRun{ID: "a3", Status: "queued", Trigger: "nightly"})
+		store.add("pipeline-a", mockRun{ID: "a4", Status: "waiting", Trigger: "push"})
+		store.add("pipeline-b", mockRun{ID: "b1", Status: "pending", Trigger: "push"})
+		testServer = store.server()
+		var serviceErr error
+		cdTektonPipelineService, serviceErr = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	resultIDs := func(report *cdtektonpipelinev2.PipelineRunCancelReport) []string {
+		ids := []string{}
+		for _, result := range report.Results {
+			ids = append(ids, result.PipelineID+"/"+*result.Run.ID)
+		}
+		return ids
+	}
+
+	It(`Invoke CancelTektonPipelineRuns to cancel every active run`, func() {
+		report, err := cdTektonPipelineService.CancelTektonPipelineRuns(
+			cdTektonPipelineService.NewCancelTektonPipelineRunsOptions([]string{"pipeline-a", "pipeline-b"}).SetConcurrency(2))
+		Expect(err).To(BeNil())
+		Expect(resultIDs(report)).To(Equal([]string{"pipeline-a/a4", "pipeline-a/a3", "pipeline-a/a2", "pipeline-b/b1"}))
+		Expect(report.Failed()).To(BeEmpty())
+		for _, result := range report.Results {
+			Expect(result.Cancelled).To(BeTrue())
+		}
+		Expect(store.requestLog()).To(ContainElement("POST /tekton_pipelines/pipeline-b/pipeline_runs/b1/cancel"))
+		Expect(store.requestLog()).ToNot(ContainElement("POST /tekton_pipelines/pipeline-a/pipeline_runs/a1/cancel"))
+	})
+	It(`Invoke CancelTektonPipelineRuns to cancel a run changing status once`, func() {
+		store.intercept(func(res http.ResponseWriter, req *http.Request) bool {
+			if req.URL.Path == "/tekton_pipelines/pipeline-a/pipeline_runs" && req.URL.Query().Get("status") == "queued" {
+				store.runs["pipeline-a"][0].Status = "running"
+			}
+			return false
+		})
+		report, err := cdTektonPipelineService.CancelTektonPipelineRuns(
+			cdTektonPipelineService.NewCancelTektonPipelineRunsOptions([]string{"pipeline-a"}))
+		Expect(err).To(BeNil())
+		Expect(resultIDs(report)).To(Equal([]string{"pipeline-a/a4", "pipeline-a/a3", "pipeline-a/a2"}))
+	})
+	It(`Invoke CancelTektonPipelineRuns with filters in dry-run mode`, func() {
+		createdBefore := strfmt.DateTime(time.Date(2026, 1, 1, 0, 0, 3, 0, time.UTC))
+		report, err := cdTektonPipelineService.CancelTektonPipelineRuns(
+			cdTektonPipelineService.NewCancelTektonPipelineRunsOptions([]string{"pipeline-a"}).
+				SetStatuses([]string{"running", "waiting"}).
+				SetTriggerName("push").
+				SetCreatedBefore(&createdBefore).
+				SetDryRun(true))
+		Expect(err).To(BeNil())
+		Expect(report.DryRun).To(BeTrue())
+		Expect(resultIDs(report)).To(Equal([]string{"pipeline-a/a2"}))
+		Expect(report.Results[0].Cancelled).To(BeFalse())
+		for _, request := range store.requestLog() {
+			Expect(request).To(HavePrefix("GET "))
+		}
+	})
+	It(`Invoke CancelTektonPipelineRuns to report runs that could not be cancelled`, func() {
+		store.failing = map[string]bool{"a2": true}
+		report, err := cdTektonPipelineService.CancelTektonPipelineRuns(
+			cdTektonPipelineService.NewCancelTektonPipelineRunsOptions([]string{"pipeline-a"}))
+		Expect(err).To(BeNil())
+		Expect(report.Results).To(HaveLen(3))
+		failed := report.Failed()
+		Expect(failed).To(HaveLen(1))
+		Expect(*failed[0].Run.ID).To(Equal("a2"))
+		Expect(failed[0].Cancelled).To(BeFalse())
+	})
+	It(`Invoke CancelTektonPipelineRuns with error: Operation validation and request error`, func() {
+		_, err := cdTektonPipelineService.CancelTektonPipelineRuns(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.CancelTektonPipelineRuns(cdTektonPipelineService.NewCancelTektonPipelineRunsOptions(nil))
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.CancelTektonPipelineRuns(
+			cdTektonPipelineService.NewCancelTektonPipelineRunsOptions([]string{"pipeline-a"}).SetStatuses([]string{"succeeded"}))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("cannot cancel runs in status 'succeeded'"))
+	})
+})
@@ -0,0 +1,284 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+
+	// The embedded timezone database is used when the system has none, so that the IANA timezones of the timer
+	// triggers are recognized on every platform.
+	_ "time/tzdata"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// MinCronInterval is the maximum frequency of timer triggers: their cron expressions cannot fire more often.
+const MinCronInterval = 5 * time.Minute
+
+// cronSearchDays bounds the search of the next fire time. Every valid expression fires within 28 years, the period
+// after which the calendar repeats.
+const cronSearchDays = 366 * 29
+
+// cronField describes a field of a cron expression.
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names []string
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: []string{"", "JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	{name: "day of week", min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+}
+
+// CronSchedule : The schedule of a timer trigger, parsed from its cron expression and timezone by ParseCronSchedule.
+type CronSchedule struct {
+	// The cron expression.
+	Expression string
+
+	// The timezone, `UTC` when the trigger has none.
+	Timezone string
+
+	location *time.Location
+
+	// The sets of values of the day of month, month and day of week fields, as bit masks. Sunday is day 0.
+	days     uint64
+	months   uint64
+	weekdays uint64
+
+	// Whether the day of month or day of week field starts with `*`, in which case only the other field restricts
+	// the days.
+	daysStar     bool
+	weekdaysStar bool
+
+	// The fire times in a day, in minutes since midnight, sorted.
+	times []int
+}
+
+// ParseCronSchedule parses the cron expression and the timezone of a timer trigger. The expression uses the UNIX
+// crontab syntax: five fields for the minute, hour, day of month, month and day of week, each made of comma-separated
+// values, ranges such as `1-5`, and steps such as `*/15` or `10-50/20`. Months and days of week can also be named by
+// their first three letters, and Sunday is either 0 or 7. When both the day of month and the day of week are
+// restricted, the schedule fires on the days matching either of them.
+//
+// The expression is rejected if it fires more often than every 5 minutes (MinCronInterval) or never fires, and the
+// timezone if it is not in the IANA timezone database. An empty timezone stands for UTC.
+func ParseCronSchedule(expression string, timezone string) (schedule *CronSchedule, err error) {
+	schedule = &CronSchedule{Expression: expression, Timezone: timezone}
+	if schedule.Timezone == "" {
+		schedule.Timezone = "UTC"
+	}
+	if schedule.Timezone == "Local" {
+		err = core.SDKErrorf(nil, fmt.Sprintf("unknown timezone '%s'", timezone), "invalid-cron-timezone", common.GetComponentInfo())
+		return nil, err
+	}
+	schedule.location, err = time.LoadLocation(schedule.Timezone)
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("unknown timezone '%s'", timezone), "invalid-cron-timezone", common.GetComponentInfo())
+		return nil, err
+	}
+
+	fields := strings.Fields(expression)
+	if len(fields) != len(cronFields) {
+		err = core.SDKErrorf(nil, fmt.Sprintf("invalid cron expression '%s': expected 5 fields, found %d", expression, len(fields)), "invalid-cron-expression", common.GetComponentInfo())
+		return nil, err
+	}
+	var masks [5]uint64
+	for index, field := range fields {
+		masks[index], err = parseCronField(field, cronFields[index])
+		if err != nil {
+			err = core.SDKErrorf(nil, fmt.Sprintf("invalid cron expression '%s': %s", expression, err.Error()), "invalid-cron-expression", common.GetComponentInfo())
+			return nil, err
+		}
+	}
+	schedule.days, schedule.months = masks[2], masks[3]
+	schedule.weekdays = masks[4]&^(1<<7) | masks[4]>>7
+	schedule.daysStar = strings.HasPrefix(fields[2], "*")
+	schedule.weekdaysStar = strings.HasPrefix(fields[4], "*")
+	for hours := masks[1]; hours != 0; hours &= hours - 1 {
+		for minutes := masks[0]; minutes != 0; minutes &= minutes - 1 {
+			schedule.times = append(schedule.times, bits.TrailingZeros64(hours)*60+bits.TrailingZeros64(minutes))
+		}
+	}
+
+	if interval := schedule.minInterval(); interval < MinCronInterval {
+		err = core.SDKErrorf(nil, fmt.Sprintf("cron expression '%s' fires %s apart, more often than every %s", expression, strings.TrimSuffix(interval.String(), "0s"), strings.TrimSuffix(MinCronInterval.String(), "0s")), "cron-too-frequent", common.GetComponentInfo())
+		return nil, err
+	}
+	if !schedule.firesAnyDay() {
+		err = core.SDKErrorf(nil, fmt.Sprintf("cron expression '%s' never fires", expression), "invalid-cron-expression", common.GetComponentInfo())
+		return nil, err
+	}
+	return
+}
+
+// parseCronField returns the bit mask of the values of a field.
+func parseCronField(field string, definition cronField) (mask uint64, err error) {
+	for _, part := range strings.Split(field, ",") {
+		valueRange, stepText, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			step, err = strconv.Atoi(stepText)
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step '%s' in %s field '%s'", stepText, definition.name, field)
+			}
+		}
+		low, high := definition.min, definition.max
+		if valueRange != "*" {
+			lowText, highText, isRange := strings.Cut(valueRange, "-")
+			if low, err = parseCronValue(lowText, definition); err != nil {
+				return 0, fmt.Errorf("%s in %s field '%s'", err.Error(), definition.name, field)
+			}
+			switch {
+			case isRange:
+				if high, err = parseCronValue(highText, definition); err != nil {
+					return 0, fmt.Errorf("%s in %s field '%s'", err.Error(), definition.name, field)
+				}
+				if high < low {
+					return 0, fmt.Errorf("invalid range '%s' in %s field '%s'", valueRange, definition.name, field)
+				}
+			case !hasStep:
+				high = low
+			}
+		}
+		for value := low; value <= high; value += step {
+			mask |= 1 << value
+		}
+	}
+	return
+}
+
+// parseCronValue parses a number or a name of a field.
+func parseCronValue(text string, definition cronField) (int, error) {
+	for value, name := range definition.names {
+		if name != "" && strings.EqualFold(text, name) {
+			return value, nil
+		}
+	}
+	value, err := strconv.Atoi(text)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value '%s'", text)
+	}
+	if value < definition.min || value > definition.max {
+		return 0, fmt.Errorf("value %d out of range %d-%d", value, definition.min, definition.max)
+	}
+	return value, nil
+}
+
+// minInterval returns the shortest interval between two fire times, on the wall clock.
+func (schedule *CronSchedule) minInterval() time.Duration {
+	interval := 24*60 - schedule.times[len(schedule.times)-1] + schedule.times[0]
+	for index := 1; index < len(schedule.times); index++ {
+		interval = min(interval, schedule.times[index]-schedule.times[index-1])
+	}
+	return time.Duration(interval) * time.Minute
+}
+
+// firesAnyDay tells whether some day matches the schedule, which is not the case of February 30. Every day of week
+// occurs in every month, so only the day of month field can make a schedule never fire.
+func (schedule *CronSchedule) firesAnyDay() bool {
+	if !schedule.weekdaysStar {
+		return true
+	}
+	for month := time.January; month <= time.December; month++ {
+		// 2024 is a leap year, so February has 29 days.
+		days := time.Date(2024, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+		if schedule.months&(1<<month) != 0 && schedule.days&(1<<(days+1)-1) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesDay tells whether the schedule fires on a day.
+func (schedule *CronSchedule) matchesDay(year int, month time.Month, day int) bool {
+	if schedule.months&(1<<month) == 0 {
+		return false
+	}
+	dayMatches := schedule.days&(1<<day) != 0
+	weekdayMatches := schedule.weekdays&(1<<time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday()) != 0
+	if schedule.daysStar || schedule.weekdaysStar {
+		return dayMatches && weekdayMatches
+	}
+	return dayMatches || weekdayMatches
+}
+
+// Location returns the location of the timezone of the schedule.
+func (schedule *CronSchedule) Location() *time.Location {
+	return schedule.location
+}
+
+// Next returns the first fire time after the specified time, in the timezone of the schedule. The fire times follow
+// the wall clock of the timezone across DST changes: a fire time skipped when the clocks go forward does not fire,
+// and a fire time repeated when the clocks go back fires once, at its first occurrence.
+func (schedule *CronSchedule) Next(after time.Time) time.Time {
+	start := after.In(schedule.location)
+	year, month, day := start.Date()
+	for offset := 0; offset < cronSearchDays; offset++ {
+		date := time.Date(year, month, day+offset, 0, 0, 0, 0, time.UTC)
+		if !schedule.matchesDay(date.Year(), date.Month(), date.Day()) {
+			continue
+		}
+		for _, minutes := range schedule.times {
+			wall := date.Add(time.Duration(minutes) * time.Minute)
+			if fireTime, found := schedule.wallClockTime(wall); found && fireTime.After(after) {
+				return fireTime
+			}
+		}
+	}
+	return time.Time{}
+}
+
+// NextN returns the next count fire times after the specified time, in the timezone of the schedule.
+func (schedule *CronSchedule) NextN(after time.Time, count int) (fireTimes []time.Time) {
+	for len(fireTimes) < count {
+		after = schedule.Next(after)
+		if after.IsZero() {
+			break
+		}
+		fireTimes = append(fireTimes, after)
+	}
+	return
+}
+
+// wallClockTime returns the first time at which the clocks of the timezone show the specified wall clock time,
+// expressed in UTC, and false if they never do.
+func (schedule *CronSchedule) wallClockTime(wall time.Time) (result time.Time, found bool) {
+	for _, probe := range []time.Duration{-12 * time.Hour, 0, 12 * time.Hour} {
+		_, offset := wall.Add(probe).In(schedule.location).Zone()
+		candidate := wall.Add(-time.Duration(offset) * time.Second).In(schedule.location)
+		local := time.Date(candidate.Year(), candidate.Month(), candidate.Day(), candidate.Hour(), candidate.Minute(), 0, 0, time.UTC)
+		if local.Equal(wall) && (!found || candidate.Before(result)) {
+			result, found = candidate, true
+		}
+	}
+	return
+}
+
+// CronSchedule parses the cron expression and timezone of a timer trigger with ParseCronSchedule.
+func (trigger *Trigger) CronSchedule() (*CronSchedule, error) {
+	return ParseCronSchedule(core.StringNilMapper(trigger.Cron), core.StringNilMapper(trigger.Timezone))
+}
@@ -0,0 +1,115 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CdTektonPipelineV2 cron schedules`, func() {
+	format := func(times []time.Time) (formatted []string) {
+		for _, fireTime := range times {
+			formatted = append(formatted, fireTime.Format("2006-01-02 15:04 MST"))
+		}
+		return
+	}
+
+	It(`Invoke ParseCronSchedule and NextN successfully`, func() {
+		schedule, err := cdtektonpipelinev2.ParseCronSchedule("0 */2 * * *", "Europe/Berlin")
+		Expect(err).To(BeNil())
+		Expect(schedule.Location().String()).To(Equal("Europe/Berlin"))
+
+		// The clocks go forward from 02:00 CET to 03:00 CEST: 02:00 is skipped.
+		Expect(format(schedule.NextN(time.Date(2026, 3, 28, 21, 0, 0, 0, time.UTC), 3))).To(Equal([]string{
+			"2026-03-29 00:00 CET", "2026-03-29 04:00 CEST", "2026-03-29 06:00 CEST",
+		}))
+		// The clocks go back from 03:00 CEST to 02:00 CET: 02:00 fires once.
+		Expect(format(schedule.NextN(time.Date(2026, 10, 24, 21, 0, 0, 0, time.UTC), 3))).To(Equal([]string{
+			"2026-10-25 00:00 CEST", "2026-10-25 02:00 CEST", "2026-10-25 04:00 CET",
+		}))
+		Expect(format(schedule.NextN(time.Date(2026, 10, 25, 0, 30, 0, 0, time.UTC), 1))).To(Equal([]string{"2026-10-25 04:00 CET"}))
+
+		for _, test := range []struct {
+			expression string
+			timezone   string
+			expected   []string
+		}{
+			{"*/5 * * * *", "", []string{"2026-01-01 00:05 UTC", "2026-01-01 00:10 UTC"}},
+			{"30 9 * * mon-fri", "America/New_York", []string{"2026-01-01 09:30 EST", "2026-01-02 09:30 EST", "2026-01-05 09:30 EST"}},
+			{"0 0 1,15 * 0", "UTC", []string{"2026-01-04 00:00 UTC", "2026-01-11 00:00 UTC", "2026-01-15 00:00 UTC"}},
+			{"0 0 */10 * *", "UTC", []string{"2026-01-11 00:00 UTC", "2026-01-21 00:00 UTC", "2026-01-31 00:00 UTC", "2026-02-01 00:00 UTC"}},
+			{"0 0 * * 7", "UTC", []string{"2026-01-04 00:00 UTC"}},
+			{"0 12 29 FEB *", "UTC", []string{"2028-02-29 12:00 UTC", "2032-02-29 12:00 UTC"}},
+			{"10-50/20 3 * jan,DEC *", "Asia/Kolkata", []string{"2026-01-02 03:10 IST", "2026-01-02 03:30 IST", "2026-01-02 03:50 IST"}},
+			{"55,0 * * * *", "", []string{"2026-01-01 00:55 UTC", "2026-01-01 01:00 UTC"}},
+		} {
+			schedule, err := cdtektonpipelinev2.ParseCronSchedule(test.expression, test.timezone)
+			Expect(err).To(BeNil(), test.expression)
+			Expect(format(schedule.NextN(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), len(test.expected)))).To(Equal(test.expected), test.expression)
+		}
+
+		trigger := &cdtektonpipelinev2.Trigger{Cron: core.StringPtr("0 2 * * *"), Timezone: core.StringPtr("Europe/Paris")}
+		schedule, err = trigger.CronSchedule()
+		Expect(err).To(BeNil())
+		Expect(schedule.Next(time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC))).To(BeTemporally("==", time.Date(2026, 7, 2, 0, 0, 0, 0, time.UTC)))
+	})
+	It(`Invoke ParseCronSchedule with error: Invalid schedules`, func() {
+		for expression, message := range map[string]string{
+			"* * * *":       "expected 5 fields, found 4",
+			"0 0 * * * *":   "expected 5 fields, found 6",
+			"@hourly":       "expected 5 fields, found 1",
+			"60 * * * *":    "value 60 out of range 0-59 in minute field '60'",
+			"0 24 * * *":    "value 24 out of range 0-23 in hour field '24'",
+			"0 0 0 * *":     "value 0 out of range 1-31 in day of month field '0'",
+			"0 0 * foo *":   "invalid value 'foo' in month field 'foo'",
+			"0 0 * * 1-8":   "value 8 out of range 0-7 in day of week field '1-8'",
+			"0 0 * * 5-1":   "invalid range '5-1' in day of week field '5-1'",
+			"*/0 * * * *":   "invalid step '0' in minute field '*/0'",
+			"0,,30 * * * *": "invalid value '' in minute field '0,,30'",
+			"* * * * *":     "fires 1m apart, more often than every 5m",
+			"*/3 * * * *":   "fires 3m apart",
+			"0,58 * * * *":  "fires 2m apart",
+			"0 0 30 2 *":    "never fires",
+			"0 0 31 4,6 *":  "never fires",
+		} {
+			_, err := cdtektonpipelinev2.ParseCronSchedule(expression, "")
+			Expect(err).ToNot(BeNil(), expression)
+			Expect(err.Error()).To(ContainSubstring(message), expression)
+		}
+
+		for _, timezone := range []string{"Mars/Olympus", "Local"} {
+			_, err := cdtektonpipelinev2.ParseCronSchedule("0 0 * * *", timezone)
+			Expect(err).ToNot(BeNil(), timezone)
+			Expect(err.Error()).To(ContainSubstring("unknown timezone '" + timezone + "'"))
+		}
+	})
+	It(`Invoke TektonPipelineSpec Validate with error: Invalid timer trigger`, func() {
+		_, err := cdtektonpipelinev2.ParseTektonPipelineSpec([]byte(`triggers:
+- name: nightly
+  type: timer
+  event_listener: listener
+  cron: "*/2 * * * *"
+`))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("invalid schedule of trigger 'nightly': cron expression '*/2 * * * *' fires 2m apart"))
+	})
+})
@@ -0,0 +1,756 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+)
+
+// pipelineRunDAGNodeNamePattern matches the valid DAG node names. Node names are part of the idempotency keys of the
+// runs created by OrchestrateTektonPipelineRuns.
+var pipelineRunDAGNodeNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// PipelineRunDAG : A directed acyclic graph of pipeline runs, possibly across pipelines and toolchains.
+type PipelineRunDAG struct {
+	// The nodes of the graph. Each node is run once all the nodes it depends on succeeded.
+	Nodes []PipelineRunDAGNode `json:"nodes" validate:"required,min=1,dive"`
+}
+
+// NewPipelineRunDAG : Instantiate PipelineRunDAG (Generic Model Constructor)
+func (*CdTektonPipelineV2) NewPipelineRunDAG(nodes []PipelineRunDAGNode) (_model *PipelineRunDAG, err error) {
+	_model = &PipelineRunDAG{
+		Nodes: nodes,
+	}
+	err = core.ValidateStruct(_model, "required parameters")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-missing-required", common.GetComponentInfo())
+		return
+	}
+	_, err = _model.Order()
+	return
+}
+
+// PipelineRunDAGNode : A node of a PipelineRunDAG: a run of a pipeline trigger.
+type PipelineRunDAGNode struct {
+	// The name of the node, made of 1 to 64 letters, digits, `_` or `-`.
+	Name *string `json:"name" validate:"required,ne="`
+
+	// The Tekton pipeline ID.
+	PipelineID *string `json:"pipeline_id" validate:"required,ne="`
+
+	// Trigger name.
+	TriggerName *string `json:"trigger_name" validate:"required,ne="`
+
+	// The names of the nodes that must succeed before this node is run.
+	DependsOn []string `json:"depends_on,omitempty"`
+
+	// Trigger properties of the run.
+	TriggerProperties map[string]interface{} `json:"trigger_properties,omitempty"`
+
+	// Secure trigger properties of the run.
+	SecureTriggerProperties map[string]interface{} `json:"secure_trigger_properties,omitempty"`
+
+	// Trigger properties of the run whose values are taken from the properties of upstream runs.
+	Bindings []PipelineRunDAGBinding `json:"bindings,omitempty" validate:"dive"`
+}
+
+// NewPipelineRunDAGNode : Instantiate PipelineRunDAGNode (Generic Model Constructor)
+func (*CdTektonPipelineV2) NewPipelineRunDAGNode(name string, pipelineID string, triggerName string) (_model *PipelineRunDAGNode, err error) {
+	_model = &PipelineRunDAGNode{
+		Name:        core.StringPtr(name),
+		PipelineID:  core.StringPtr(pipelineID),
+		TriggerName: core.StringPtr(triggerName),
+	}
+	err = core.ValidateStruct(_model, "required parameters")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-missing-required", common.GetComponentInfo())
+	}
+	return
+}
+
+// PipelineRunDAGBinding : Passes the value of a property of an upstream run as a trigger property of a downstream run.
+type PipelineRunDAGBinding struct {
+	// The name of the trigger property of the downstream run.
+	Property *string `json:"property" validate:"required,ne="`
+
+	// The name of the upstream node. The downstream node must depend on it.
+	Node *string `json:"node" validate:"required,ne="`
+
+	// The name of the property of the upstream run. Secure properties cannot be passed.
+	NodeProperty *string `json:"node_property" validate:"required,ne="`
+}
+
+// NewPipelineRunDAGBinding : Instantiate PipelineRunDAGBinding (Generic Model Constructor)
+func (*CdTektonPipelineV2) NewPipelineRunDAGBinding(property string, node string, nodeProperty string) (_model *PipelineRunDAGBinding, err error) {
+	_model = &PipelineRunDAGBinding{
+		Property:     core.StringPtr(property),
+		Node:         core.StringPtr(node),
+		NodeProperty: core.StringPtr(nodeProperty),
+	}
+	err = core.ValidateStruct(_model, "required parameters")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-missing-required", common.GetComponentInfo())
+	}
+	return
+}
+
+// Order checks the graph and returns the names of its nodes in a topological order. Nodes without ordering constraint
+// keep their declaration order. An error is returned for invalid or duplicate node names, unknown dependencies,
+// bindings to nodes that are not dependencies, and cycles.
+func (dag *PipelineRunDAG) Order() (order []string, err error) {
+	invalid := func(format string, args ...interface{}) error {
+		return core.SDKErrorf(nil, fmt.Sprintf(format, args...), "invalid-dag", common.GetComponentInfo())
+	}
+
+	indexes := make(map[string]int, len(dag.Nodes))
+	for index, node := range dag.Nodes {
+		name := core.StringNilMapper(node.Name)
+		if !pipelineRunDAGNodeNamePattern.MatchString(name) {
+			return nil, invalid("invalid node name '%s'", name)
+		}
+		if _, ok := indexes[name]; ok {
+			return nil, invalid("duplicate node '%s'", name)
+		}
+		indexes[name] = index
+	}
+
+	pending := make([]int, len(dag.Nodes))
+	downstream := make([][]int, len(dag.Nodes))
+	for index, node := range dag.Nodes {
+		dependencies := make(map[string]bool, len(node.DependsOn))
+		for _, dependency := range node.DependsOn {
+			upstream, ok := indexes[dependency]
+			if !ok || upstream == index {
+				return nil, invalid("node '%s' depends on invalid node '%s'", *node.Name, dependency)
+			}
+			if !dependencies[dependency] {
+				dependencies[dependency] = true
+				pending[index]++
+				downstream[upstream] = append(downstream[upstream], index)
+			}
+		}
+		for _, binding := range node.Bindings {
+			if !dependencies[core.StringNilMapper(binding.Node)] {
+				return nil, invalid("node '%s' binds property '%s' to node '%s', which it does not depend on",
+					*node.Name, core.StringNilMapper(binding.Property), core.StringNilMapper(binding.Node))
+			}
+		}
+	}
+
+	done := make([]bool, len(dag.Nodes))
+	for len(order) < len(dag.Nodes) {
+		next := -1
+		for index := range dag.Nodes {
+			if !done[index] && pending[index] == 0 {
+				next = index
+				break
+			}
+		}
+		if next < 0 {
+			return nil, invalid("the graph has a cycle")
+		}
+		done[next] = true
+		order = append(order, *dag.Nodes[next].Name)
+		for _, index := range downstream[next] {
+			pending[index]--
+		}
+	}
+	return
+}
+
+// PipelineRunDAGNodeStatus : The status of a node run by OrchestrateTektonPipelineRuns.
+type PipelineRunDAGNodeStatus string
+
+// Constants associated with the PipelineRunDAGNodeStatus type.
+const (
+	PipelineRunDAGNodeStatusPendingConst   PipelineRunDAGNodeStatus = "pending"
+	PipelineRunDAGNodeStatusRunningConst   PipelineRunDAGNodeStatus = "running"
+	PipelineRunDAGNodeStatusSucceededConst PipelineRunDAGNodeStatus = "succeeded"
+	PipelineRunDAGNodeStatusFailedConst    PipelineRunDAGNodeStatus = "failed"
+	PipelineRunDAGNodeStatusCancelledConst PipelineRunDAGNodeStatus = "cancelled"
+	PipelineRunDAGNodeStatusSkippedConst   PipelineRunDAGNodeStatus = "skipped"
+)
+
+// PipelineRunDAGState : The progress of OrchestrateTektonPipelineRuns, as persisted in its state file.
+type PipelineRunDAGState struct {
+	// A random identifier of the orchestration, used in the idempotency keys of the runs.
+	ID string `json:"id"`
+
+	// The state of each node, by node name.
+	Nodes map[string]*PipelineRunDAGNodeState `json:"nodes"`
+}
+
+// PipelineRunDAGNodeState : The state of a node of a PipelineRunDAG.
+type PipelineRunDAGNodeState struct {
+	Status PipelineRunDAGNodeStatus `json:"status"`
+
+	// The Tekton pipeline ID.
+	PipelineID string `json:"pipeline_id"`
+
+	// The attempt number, incremented each time the node is run again after a failure.
+	Attempt int `json:"attempt"`
+
+	// The ID and URL of the run of the current attempt.
+	RunID  string `json:"run_id,omitempty"`
+	RunURL string `json:"run_url,omitempty"`
+
+	// The non-secure properties of the run, once it succeeded.
+	Properties map[string]string `json:"properties,omitempty"`
+
+	// Why the node failed, was cancelled or was skipped.
+	Error string `json:"error,omitempty"`
+
+	StartedAt  *strfmt.DateTime `json:"started_at,omitempty"`
+	FinishedAt *strfmt.DateTime `json:"finished_at,omitempty"`
+}
+
+// Succeeded returns true when every node succeeded.
+func (state *PipelineRunDAGState) Succeeded() bool {
+	for _, node := range state.Nodes {
+		if node.Status != PipelineRunDAGNodeStatusSucceededConst {
+			return false
+		}
+	}
+	return true
+}
+
+// LoadPipelineRunDAGState reads a state file written by OrchestrateTektonPipelineRuns.
+func LoadPipelineRunDAGState(path string) (state *PipelineRunDAGState, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "dag-state-load-error", common.GetComponentInfo())
+		return
+	}
+	state = &PipelineRunDAGState{}
+	if err = json.Unmarshal(data, state); err != nil {
+		err = core.SDKErrorf(err, "", "dag-state-load-error", common.GetComponentInfo())
+		return nil, err
+	}
+	return
+}
+
+// Save writes the state to a file. The file is replaced atomically, so that it is never left half written.
+func (state *PipelineRunDAGState) Save(path string) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return core.SDKErrorf(err, "", "dag-state-save-error", common.GetComponentInfo())
+	}
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return core.SDKErrorf(err, "", "dag-state-save-error", common.GetComponentInfo())
+	}
+	_, err = file.Write(append(data, '\n'))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), path)
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return core.SDKErrorf(err, "", "dag-state-save-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// PipelineRunDAGCallback is invoked by OrchestrateTektonPipelineRuns each time the state of a node changes.
+type PipelineRunDAGCallback func(name string, node *PipelineRunDAGNodeState)
+
+// OrchestrateTektonPipelineRunsOptions : The OrchestrateTektonPipelineRuns options.
+type OrchestrateTektonPipelineRunsOptions struct {
+	// The graph of pipeline runs.
+	DAG *PipelineRunDAG `json:"dag" validate:"required"`
+
+	// Optional path of the state file. When it exists, the orchestration is resumed from it.
+	StateFile string
+
+	// When true, the first node that does not succeed stops the orchestration: the runs in progress are cancelled and
+	// the pending nodes are skipped. Otherwise, only the nodes downstream of the failure are skipped.
+	FailFast bool
+
+	// When true, the runs in progress are cancelled when the context is done. Otherwise they are left running, and
+	// are waited for when the orchestration is resumed.
+	CancelOnStop bool
+
+	// Optional callback invoked on every node state change.
+	OnNodeChange PipelineRunDAGCallback
+
+	// Delay before the second poll of each run. Defaults to DefaultWaitPollInterval.
+	PollInterval time.Duration
+
+	// Upper bound for the delay between two polls of each run. Defaults to DefaultWaitMaxPollInterval.
+	MaxPollInterval time.Duration
+
+	// Maximum time to wait for each run. A run that takes longer is cancelled and its node fails. When zero, only the
+	// deadline of the context applies.
+	RunTimeout time.Duration
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewOrchestrateTektonPipelineRunsOptions : Instantiate OrchestrateTektonPipelineRunsOptions
+func (*CdTektonPipelineV2) NewOrchestrateTektonPipelineRunsOptions(dag *PipelineRunDAG) *OrchestrateTektonPipelineRunsOptions {
+	return &OrchestrateTektonPipelineRunsOptions{
+		DAG: dag,
+	}
+}
+
+// SetDAG : Allow user to set DAG
+func (_options *OrchestrateTektonPipelineRunsOptions) SetDAG(dag *PipelineRunDAG) *OrchestrateTektonPipelineRunsOptions {
+	_options.DAG = dag
+	return _options
+}
+
+// SetStateFile : Allow user to set StateFile
+func (_options *OrchestrateTektonPipelineRunsOptions) SetStateFile(stateFile string) *OrchestrateTektonPipelineRunsOptions {
+	_options.StateFile = stateFile
+	return _options
+}
+
+// SetFailFast : Allow user to set FailFast
+func (_options *OrchestrateTektonPipelineRunsOptions) SetFailFast(failFast bool) *OrchestrateTektonPipelineRunsOptions {
+	_options.FailFast = failFast
+	return _options
+}
+
+// SetCancelOnStop : Allow user to set CancelOnStop
+func (_options *OrchestrateTektonPipelineRunsOptions) SetCancelOnStop(cancelOnStop bool) *OrchestrateTektonPipelineRunsOptions {
+	_options.CancelOnStop = cancelOnStop
+	return _options
+}
+
+// SetOnNodeChange : Allow user to set OnNodeChange
+func (_options *OrchestrateTektonPipelineRunsOptions) SetOnNodeChange(onNodeChange PipelineRunDAGCallback) *OrchestrateTektonPipelineRunsOptions {
+	_options.OnNodeChange = onNodeChange
+	return _options
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (_options *OrchestrateTektonPipelineRunsOptions) SetPollInterval(pollInterval time.Duration) *OrchestrateTektonPipelineRunsOptions {
+	_options.PollInterval = pollInterval
+	return _options
+}
+
+// SetMaxPollInterval : Allow user to set MaxPollInterval
+func (_options *OrchestrateTektonPipelineRunsOptions) SetMaxPollInterval(maxPollInterval time.Duration) *OrchestrateTektonPipelineRunsOptions {
+	_options.MaxPollInterval = maxPollInterval
+	return _options
+}
+
+// SetRunTimeout : Allow user to set RunTimeout
+func (_options *OrchestrateTektonPipelineRunsOptions) SetRunTimeout(runTimeout time.Duration) *OrchestrateTektonPipelineRunsOptions {
+	_options.RunTimeout = runTimeout
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *OrchestrateTektonPipelineRunsOptions) SetHeaders(param map[string]string) *OrchestrateTektonPipelineRunsOptions {
+	options.Headers = param
+	return options
+}
+
+// OrchestrateTektonPipelineRuns : Run a graph of pipeline runs
+// This runs each node of the graph once all the nodes it depends on succeeded, passing the bound properties of the
+// upstream runs to the downstream runs. When a node does not succeed, the nodes downstream of it are skipped. A run
+// whose status cannot be read because the service is unreachable or answers with a 429 or 5xx status code is waited
+// for again.
+//
+// The state of the nodes is saved to the state file after each change. When the state file exists, the orchestration
+// is resumed: succeeded nodes are kept, the runs in progress are waited for, and failed, cancelled and skipped nodes
+// are run again. The runs are created with an idempotency key (see CreateTektonPipelineRunIdempotent), so that a run
+// created right before a crash is not created twice.
+//
+// The returned state is set even when an error is returned.
+func (cdTektonPipeline *CdTektonPipelineV2) OrchestrateTektonPipelineRuns(orchestrateTektonPipelineRunsOptions *OrchestrateTektonPipelineRunsOptions) (result *PipelineRunDAGState, err error) {
+	result, err = cdTektonPipeline.OrchestrateTektonPipelineRunsWithContext(context.Background(), orchestrateTektonPipelineRunsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// OrchestrateTektonPipelineRunsWithContext is an alternate form of the OrchestrateTektonPipelineRuns method which supports a Context parameter.
+func (cdTektonPipeline *CdTektonPipelineV2) OrchestrateTektonPipelineRunsWithContext(ctx context.Context, orchestrateTektonPipelineRunsOptions *OrchestrateTektonPipelineRunsOptions) (result *PipelineRunDAGState, err error) {
+	err = core.ValidateNotNil(orchestrateTektonPipelineRunsOptions, "orchestrateTektonPipelineRunsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(orchestrateTektonPipelineRunsOptions, "orchestrateTektonPipelineRunsOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	order, err := orchestrateTektonPipelineRunsOptions.DAG.Order()
+	if err != nil {
+		return
+	}
+
+	stopCtx, stop := context.WithCancel(ctx)
+	defer stop()
+	execution := &pipelineRunDAGExecution{
+		service: cdTektonPipeline,
+		options: orchestrateTektonPipelineRunsOptions,
+		order:   order,
+		nodes:   make(map[string]*PipelineRunDAGNode, len(order)),
+		ctx:     ctx,
+		stopCtx: stopCtx,
+		stop:    stop,
+		results: make(chan pipelineRunDAGResult),
+	}
+	for index := range orchestrateTektonPipelineRunsOptions.DAG.Nodes {
+		node := &orchestrateTektonPipelineRunsOptions.DAG.Nodes[index]
+		execution.nodes[*node.Name] = node
+	}
+
+	result, err = execution.loadState()
+	if err != nil {
+		return
+	}
+	err = execution.run()
+	return
+}
+
+// pipelineRunDAGResult is the outcome of the wait for the run of a node.
+type pipelineRunDAGResult struct {
+	name    string
+	run     *PipelineRun
+	outcome PipelineRunOutcome
+	err     error
+}
+
+// pipelineRunDAGExecution holds the progress of OrchestrateTektonPipelineRuns. Its state is only modified by the
+// goroutine running the orchestration; the goroutines waiting for the runs report to it through the results channel.
+type pipelineRunDAGExecution struct {
+	service *CdTektonPipelineV2
+	options *OrchestrateTektonPipelineRunsOptions
+	order   []string
+	nodes   map[string]*PipelineRunDAGNode
+	state   *PipelineRunDAGState
+
+	// ctx is the context of the orchestration, and stopCtx is also cancelled when it fails fast or cannot save its
+	// state. The waits for the runs use stopCtx.
+	ctx     context.Context
+	stopCtx context.Context
+	stop    context.CancelFunc
+
+	results chan pipelineRunDAGResult
+	running int
+	saveErr error
+}
+
+// loadState reads the state file, if any, and resets the nodes to run again.
+func (execution *pipelineRunDAGExecution) loadState() (state *PipelineRunDAGState, err error) {
+	if execution.options.StateFile != "" {
+		if _, statErr := os.Stat(execution.options.StateFile); statErr == nil {
+			state, err = LoadPipelineRunDAGState(execution.options.StateFile)
+			if err != nil {
+				return
+			}
+		}
+	}
+	if state == nil {
+		id := make([]byte, 8)
+		if _, err = rand.Read(id); err != nil {
+			err = core.SDKErrorf(err, "", "dag-state-id-error", common.GetComponentInfo())
+			return
+		}
+		state = &PipelineRunDAGState{ID: hex.EncodeToString(id)}
+	}
+	if state.Nodes == nil {
+		state.Nodes = make(map[string]*PipelineRunDAGNodeState)
+	}
+	for name := range state.Nodes {
+		if execution.nodes[name] == nil {
+			err = core.SDKErrorf(nil, fmt.Sprintf("the state file has unknown node '%s'", name), "dag-state-mismatch", common.GetComponentInfo())
+			return
+		}
+	}
+
+	for _, name := range execution.order {
+		nodeState := state.Nodes[name]
+		switch {
+		case nodeState == nil:
+			state.Nodes[name] = &PipelineRunDAGNodeState{
+				Status:     PipelineRunDAGNodeStatusPendingConst,
+				PipelineID: *execution.nodes[name].PipelineID,
+				Attempt:    1,
+			}
+		case nodeState.Status == PipelineRunDAGNodeStatusFailedConst ||
+			nodeState.Status == PipelineRunDAGNodeStatusCancelledConst ||
+			nodeState.Status == PipelineRunDAGNodeStatusSkippedConst:
+			state.Nodes[name] = &PipelineRunDAGNodeState{
+				Status:     PipelineRunDAGNodeStatusPendingConst,
+				PipelineID: *execution.nodes[name].PipelineID,
+				Attempt:    nodeState.Attempt + 1,
+			}
+		}
+	}
+	execution.state = state
+	if execution.options.StateFile != "" {
+		err = state.Save(execution.options.StateFile)
+	}
+	return
+}
+
+// run starts the nodes as their dependencies succeed, until no run is in progress.
+func (execution *pipelineRunDAGExecution) run() (err error) {
+	for _, name := range execution.order {
+		if execution.state.Nodes[name].Status == PipelineRunDAGNodeStatusRunningConst {
+			execution.wait(name)
+		}
+	}
+
+	for {
+		for progress := true; progress && execution.stopCtx.Err() == nil; {
+			progress = false
+			for _, name := range execution.order {
+				if execution.stopCtx.Err() != nil || execution.state.Nodes[name].Status != PipelineRunDAGNodeStatusPendingConst {
+					continue
+				}
+				ready, blocker := execution.readiness(name)
+				switch {
+				case blocker != "":
+					execution.finish(name, PipelineRunDAGNodeStatusSkippedConst, fmt.Sprintf("node '%s' did not succeed", blocker))
+					progress = true
+				case ready:
+					execution.start(name)
+					progress = true
+				}
+			}
+		}
+		if execution.running == 0 {
+			break
+		}
+		execution.handle(<-execution.results)
+	}
+
+	switch {
+	case execution.saveErr != nil:
+		err = execution.saveErr
+	case execution.ctx.Err() != nil:
+		err = core.SDKErrorf(execution.ctx.Err(), "", "dag-cancelled", common.GetComponentInfo())
+	case execution.stopCtx.Err() != nil:
+		for _, name := range execution.order {
+			if execution.state.Nodes[name].Status == PipelineRunDAGNodeStatusPendingConst {
+				execution.finish(name, PipelineRunDAGNodeStatusSkippedConst, "the orchestration failed fast")
+			}
+		}
+		err = execution.saveErr
+	}
+	return
+}
+
+// readiness tells whether all the dependencies of a node succeeded, or returns the first one that did not.
+func (execution *pipelineRunDAGExecution) readiness(name string) (ready bool, blocker string) {
+	ready = true
+	for _, dependency := range execution.nodes[name].DependsOn {
+		switch execution.state.Nodes[dependency].Status {
+		case PipelineRunDAGNodeStatusSucceededConst:
+		case PipelineRunDAGNodeStatusPendingConst, PipelineRunDAGNodeStatusRunningConst:
+			ready = false
+		default:
+			return false, dependency
+		}
+	}
+	return
+}
+
+// start creates the run of a node and starts waiting for it.
+func (execution *pipelineRunDAGExecution) start(name string) {
+	node := execution.nodes[name]
+	nodeState := execution.state.Nodes[name]
+
+	triggerProperties := make(map[string]interface{}, len(node.TriggerProperties)+len(node.Bindings))
+	for property, value := range node.TriggerProperties {
+		triggerProperties[property] = value
+	}
+	for _, binding := range node.Bindings {
+		value, ok := execution.state.Nodes[*binding.Node].Properties[*binding.NodeProperty]
+		if !ok {
+			execution.finish(name, PipelineRunDAGNodeStatusFailedConst,
+				fmt.Sprintf("the run of node '%s' has no property '%s'", *binding.Node, *binding.NodeProperty))
+			return
+		}
+		triggerProperties[*binding.Property] = value
+	}
+
+	created, err := execution.service.CreateTektonPipelineRunIdempotentWithContext(execution.stopCtx, &CreateTektonPipelineRunIdempotentOptions{
+		CreateTektonPipelineRunOptions: &CreateTektonPipelineRunOptions{
+			PipelineID:              node.PipelineID,
+			TriggerName:             node.TriggerName,
+			TriggerProperties:       triggerProperties,
+			SecureTriggerProperties: node.SecureTriggerProperties,
+			Description:             core.StringPtr("DAG node " + name),
+			Headers:                 execution.options.Headers,
+		},
+		IdempotencyKey: core.StringPtr(fmt.Sprintf("%s.%s.%d", execution.state.ID, name, nodeState.Attempt)),
+	})
+	if err != nil {
+		if execution.stopCtx.Err() == nil {
+			execution.finish(name, PipelineRunDAGNodeStatusFailedConst, err.Error())
+		}
+		return
+	}
+
+	run := created.Run
+	now := strfmt.DateTime(time.Now())
+	nodeState.Status = PipelineRunDAGNodeStatusRunningConst
+	nodeState.RunID = core.StringNilMapper(run.ID)
+	nodeState.RunURL = core.StringNilMapper(run.RunURL)
+	nodeState.StartedAt = &now
+	execution.update(name)
+	execution.wait(name)
+}
+
+// wait starts waiting for the run of a node in a goroutine.
+func (execution *pipelineRunDAGExecution) wait(name string) {
+	execution.running++
+	nodeState := execution.state.Nodes[name]
+	waitOptions := &WaitForTektonPipelineRunOptions{
+		PipelineID:      core.StringPtr(nodeState.PipelineID),
+		ID:              core.StringPtr(nodeState.RunID),
+		PollInterval:    execution.options.PollInterval,
+		MaxPollInterval: execution.options.MaxPollInterval,
+		Headers:         execution.options.Headers,
+	}
+	go func() {
+		// The timeout of the run is not restarted when the wait is retried.
+		ctx := execution.stopCtx
+		if execution.options.RunTimeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, execution.options.RunTimeout)
+			defer cancel()
+		}
+		backoff := newPollBackoff(execution.options.PollInterval, execution.options.MaxPollInterval, 0, 0)
+		for {
+			run, outcome, err := execution.service.WaitForTektonPipelineRunWithContext(ctx, waitOptions)
+			if !isTransientError(err) || execution.stopCtx.Err() != nil {
+				execution.results <- pipelineRunDAGResult{name: name, run: run, outcome: outcome, err: err}
+				return
+			}
+			// The service could not tell the status of the run, which may still be running: wait for it again.
+			timer := time.NewTimer(backoff.next())
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+			case <-timer.C:
+			}
+		}
+	}()
+}
+
+// handle updates the state of a node once the wait for its run ended.
+func (execution *pipelineRunDAGExecution) handle(result pipelineRunDAGResult) {
+	execution.running--
+	nodeState := execution.state.Nodes[result.name]
+
+	// The wait ends with a timeout when the deadline of the context expires, as well as when RunTimeout does.
+	if (result.err != nil || result.outcome == PipelineRunOutcomeTimedOutConst) && execution.stopCtx.Err() != nil {
+		interrupted := execution.ctx.Err() != nil || execution.saveErr != nil
+		if interrupted && !execution.options.CancelOnStop {
+			// The run is left running, and is waited for again when the orchestration is resumed.
+			return
+		}
+		if execution.cancel(result.name) {
+			execution.finish(result.name, PipelineRunDAGNodeStatusCancelledConst, "the orchestration was stopped")
+		}
+		return
+	}
+
+	switch {
+	case result.outcome == PipelineRunOutcomeTimedOutConst:
+		// The run is cancelled so that running the node again does not leave two runs in progress.
+		if execution.cancel(result.name) {
+			execution.finish(result.name, PipelineRunDAGNodeStatusFailedConst, "the run timed out")
+		}
+	case result.err != nil:
+		execution.finish(result.name, PipelineRunDAGNodeStatusFailedConst, result.err.Error())
+	case result.outcome == PipelineRunOutcomeSucceededConst:
+		nodeState.Properties = make(map[string]string)
+		for _, property := range result.run.Properties {
+			if core.StringNilMapper(property.Type) != PropertyTypeSecureConst && property.Name != nil {
+				nodeState.Properties[*property.Name] = core.StringNilMapper(property.Value)
+			}
+		}
+		execution.finish(result.name, PipelineRunDAGNodeStatusSucceededConst, "")
+	case result.outcome == PipelineRunOutcomeCancelledConst:
+		execution.finish(result.name, PipelineRunDAGNodeStatusCancelledConst, "the run was cancelled")
+	default:
+		message := "the run ended with outcome " + string(result.outcome)
+		if result.run != nil && result.run.ErrorMessage != nil {
+			message = *result.run.ErrorMessage
+		}
+		execution.finish(result.name, PipelineRunDAGNodeStatusFailedConst, message)
+	}
+}
+
+// cancel cancels the run of a node. When this fails, the node is left running with the error, so that the run is
+// waited for again when the orchestration is resumed.
+func (execution *pipelineRunDAGExecution) cancel(name string) bool {
+	nodeState := execution.state.Nodes[name]
+	_, _, err := execution.service.CancelTektonPipelineRunWithContext(context.WithoutCancel(execution.ctx), &CancelTektonPipelineRunOptions{
+		PipelineID: core.StringPtr(nodeState.PipelineID),
+		ID:         core.StringPtr(nodeState.RunID),
+		Headers:    execution.options.Headers,
+	})
+	if err != nil {
+		nodeState.Error = err.Error()
+		execution.update(name)
+		return false
+	}
+	return true
+}
+
+// finish sets the final status of a node. Unless the node succeeded, this stops the orchestration when it fails fast.
+func (execution *pipelineRunDAGExecution) finish(name string, status PipelineRunDAGNodeStatus, message string) {
+	now := strfmt.DateTime(time.Now())
+	nodeState := execution.state.Nodes[name]
+	nodeState.Status = status
+	nodeState.Error = message
+	nodeState.FinishedAt = &now
+	execution.update(name)
+	if execution.options.FailFast && status != PipelineRunDAGNodeStatusSucceededConst {
+		execution.stop()
+	}
+}
+
+// update reports the change of a node and saves the state. A failure to save the state stops the orchestration,
+// which could not be resumed reliably.
+func (execution *pipelineRunDAGExecution) update(name string) {
+	if execution.options.OnNodeChange != nil {
+		execution.options.OnNodeChange(name, execution.state.Nodes[name])
+	}
+	if execution.options.StateFile == "" || execution.saveErr != nil {
+		return
+	}
+	if err := execution.state.Save(execution.options.StateFile); err != nil {
+		execution.saveErr = err
+		execution.stop()
+	}
+}
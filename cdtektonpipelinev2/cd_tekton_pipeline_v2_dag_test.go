@@ -0,0 +1,273 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CdTektonPipelineV2 OrchestrateTektonPipelineRuns`, func() {
+	var store *mockRunStore
+	var testServer *httptest.Server
+	var cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+	var outcomes map[string]string
+	var stateFile string
+
+	node := func(name string, pipelineID string, dependsOn ...string) cdtektonpipelinev2.PipelineRunDAGNode {
+		model, err := cdTektonPipelineService.NewPipelineRunDAGNode(name, pipelineID, name)
+		Expect(err).To(BeNil())
+		model.DependsOn = dependsOn
+		return *model
+	}
+	// promotion returns the graph build -> test -> staging -> prod, where staging and prod deploy the image built by
+	// build, and docs only depends on build.
+	promotion := func() *cdtektonpipelinev2.PipelineRunDAG {
+		image, err := cdTektonPipelineService.NewPipelineRunDAGBinding("image", "build", "image")
+		Expect(err).To(BeNil())
+		staging := node("staging", "deploy-pipeline", "test", "build")
+		staging.Bindings = []cdtektonpipelinev2.PipelineRunDAGBinding{*image}
+		staging.TriggerProperties = map[string]interface{}{"region": "us-south"}
+		prod := node("prod", "deploy-pipeline", "staging", "build")
+		prod.Bindings = []cdtektonpipelinev2.PipelineRunDAGBinding{*image}
+		dag, err := cdTektonPipelineService.NewPipelineRunDAG([]cdtektonpipelinev2.PipelineRunDAGNode{
+			prod, staging, node("test", "test-pipeline", "build"), node("build", "build-pipeline"), node("docs", "build-pipeline", "build"),
+		})
+		Expect(err).To(BeNil())
+		return dag
+	}
+	createdRuns := func() (runs []string) {
+		for _, request := range store.requestLog() {
+			if strings.HasPrefix(request, "POST ") && strings.HasSuffix(request, "/pipeline_runs") {
+				runs = append(runs, strings.Split(request, "/")[2])
+			}
+		}
+		return
+	}
+
+	BeforeEach(func() {
+		store = newMockRunStore()
+		// The status of a created run is given by outcomes for its trigger, and build runs output an image property.
+		outcomes = map[string]string{}
+		store.onCreate = func(pipelineID string, run *mockRun) {
+			run.Status = "succeeded"
+			if outcome, ok := outcomes[run.Trigger]; ok {
+				run.Status = outcome
+			}
+			if run.Trigger == "build" {
+				run.Properties = map[string]interface{}{"image": "icr.io/app:" + run.ID}
+			}
+		}
+		testServer = store.server()
+		var serviceErr error
+		cdTektonPipelineService, serviceErr = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		stateFile = filepath.Join(GinkgoT().TempDir(), "state.json")
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Invoke NewPipelineRunDAG with an invalid graph`, func() {
+		_, err := cdTektonPipelineService.NewPipelineRunDAG(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.NewPipelineRunDAG([]cdtektonpipelinev2.PipelineRunDAGNode{node("a", "p", "b"), node("b", "p", "a")})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("cycle"))
+		_, err = cdTektonPipelineService.NewPipelineRunDAG([]cdtektonpipelinev2.PipelineRunDAGNode{node("a", "p", "missing")})
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.NewPipelineRunDAG([]cdtektonpipelinev2.PipelineRunDAGNode{node("a", "p"), node("a", "p")})
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.NewPipelineRunDAG([]cdtektonpipelinev2.PipelineRunDAGNode{node("a b", "p")})
+		Expect(err).ToNot(BeNil())
+
+		binding, err := cdTektonPipelineService.NewPipelineRunDAGBinding("image", "a", "image")
+		Expect(err).To(BeNil())
+		b := node("b", "p")
+		b.Bindings = []cdtektonpipelinev2.PipelineRunDAGBinding{*binding}
+		_, err = cdTektonPipelineService.NewPipelineRunDAG([]cdtektonpipelinev2.PipelineRunDAGNode{node("a", "p"), b})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("does not depend on"))
+
+		order, err := promotion().Order()
+		Expect(err).To(BeNil())
+		Expect(order).To(Equal([]string{"build", "test", "staging", "prod", "docs"}))
+	})
+	It(`Invoke OrchestrateTektonPipelineRuns successfully`, func() {
+		var changes []string
+		options := cdTektonPipelineService.NewOrchestrateTektonPipelineRunsOptions(promotion()).
+			SetStateFile(stateFile).
+			SetPollInterval(time.Millisecond).
+			SetOnNodeChange(func(name string, node *cdtektonpipelinev2.PipelineRunDAGNodeState) {
+				changes = append(changes, name+" "+string(node.Status))
+			})
+		state, err := cdTektonPipelineService.OrchestrateTektonPipelineRuns(options)
+		Expect(err).To(BeNil())
+		Expect(state.Succeeded()).To(BeTrue())
+		Expect(changes[:2]).To(Equal([]string{"build running", "build succeeded"}))
+		Expect(changes[len(changes)-2:]).To(Equal([]string{"prod running", "prod succeeded"}))
+		Expect(createdRuns()).To(Equal([]string{"build-pipeline", "test-pipeline", "build-pipeline", "deploy-pipeline", "deploy-pipeline"}))
+
+		Expect(state.Nodes["build"].Properties).To(HaveKeyWithValue("image", "icr.io/app:new-1"))
+		Expect(state.Nodes["staging"].Properties).To(Equal(map[string]string{"image": "icr.io/app:new-1", "region": "us-south"}))
+		Expect(state.Nodes["prod"].RunURL).To(HavePrefix("https://cloud.ibm.com/devops/pipelines/tekton/deploy-pipeline/runs/"))
+
+		saved, err := cdtektonpipelinev2.LoadPipelineRunDAGState(stateFile)
+		Expect(err).To(BeNil())
+		Expect(saved.ID).To(Equal(state.ID))
+		Expect(saved.Nodes).To(HaveLen(5))
+		Expect(saved.Nodes["staging"].Properties).To(Equal(state.Nodes["staging"].Properties))
+		Expect(saved.Nodes["prod"].Status).To(Equal(cdtektonpipelinev2.PipelineRunDAGNodeStatusSucceededConst))
+	})
+	It(`Invoke OrchestrateTektonPipelineRuns skips the nodes downstream of a failure and resumes them`, func() {
+		outcomes["test"] = "failed"
+		options := cdTektonPipelineService.NewOrchestrateTektonPipelineRunsOptions(promotion()).
+			SetStateFile(stateFile).
+			SetPollInterval(time.Millisecond)
+		state, err := cdTektonPipelineService.OrchestrateTektonPipelineRuns(options)
+		Expect(err).To(BeNil())
+		Expect(state.Succeeded()).To(BeFalse())
+		Expect(state.Nodes["test"].Status).To(Equal(cdtektonpipelinev2.PipelineRunDAGNodeStatusFailedConst))
+		Expect(state.Nodes["docs"].Status).To(Equal(cdtektonpipelinev2.PipelineRunDAGNodeStatusSucceededConst))
+		Expect(state.Nodes["staging"].Status).To(Equal(cdtektonpipelinev2.PipelineRunDAGNodeStatusSkippedConst))
+		Expect(state.Nodes["staging"].Error).To(Equal("node 'test' did not succeed"))
+		Expect(state.Nodes["prod"].Status).To(Equal(cdtektonpipelinev2.PipelineRunDAGNodeStatusSkippedConst))
+		Expect(createdRuns()).To(HaveLen(3))
+
+		delete(outcomes, "test")
+		resumed, err := cdTektonPipelineService.OrchestrateTektonPipelineRuns(options)
+		Expect(err).To(BeNil())
+		Expect(resumed.Succeeded()).To(BeTrue())
+		Expect(resumed.ID).To(Equal(state.ID))
+		Expect(resumed.Nodes["build"].Attempt).To(Equal(1))
+		Expect(resumed.Nodes["test"].Attempt).To(Equal(2))
+		Expect(createdRuns()[3:]).To(Equal([]string{"test-pipeline", "deploy-pipeline", "deploy-pipeline"}))
+	})
+	It(`Invoke OrchestrateTektonPipelineRuns resumes the runs left in progress`, func() {
+		outcomes["test"] = "running"
+		ctx, cancel := context.WithCancel(context.Background())
+		options := cdTektonPipelineService.NewOrchestrateTektonPipelineRunsOptions(promotion()).
+			SetStateFile(stateFile).
+			SetPollInterval(time.Millisecond).
+			SetOnNodeChange(func(name string, node *cdtektonpipelinev2.PipelineRunDAGNodeState) {
+				if name == "test" && node.Status == cdtektonpipelinev2.PipelineRunDAGNodeStatusRunningConst {
+					cancel()
+				}
+			})
+		state, err := cdTektonPipelineService.OrchestrateTektonPipelineRunsWithContext(ctx, options)
+		Expect(err).ToNot(BeNil())
+		Expect(state.Nodes["test"].Status).To(Equal(cdtektonpipelinev2.PipelineRunDAGNodeStatusRunningConst))
+		Expect(state.Nodes["prod"].Status).To(Equal(cdtektonpipelinev2.PipelineRunDAGNodeStatusPendingConst))
+		Expect(store.requestLog()).ToNot(ContainElement(HaveSuffix("/cancel")))
+
+		store.setStatus(state.Nodes["test"].RunID, "succeeded")
+		options.SetOnNodeChange(nil)
+		resumed, err := cdTektonPipelineService.OrchestrateTektonPipelineRuns(options)
+		Expect(err).To(BeNil())
+		Expect(resumed.Succeeded()).To(BeTrue())
+		Expect(resumed.Nodes["test"].RunID).To(Equal(state.Nodes["test"].RunID))
+		Expect(createdRuns()).To(Equal([]string{"build-pipeline", "test-pipeline", "build-pipeline", "deploy-pipeline", "deploy-pipeline"}))
+	})
+	It(`Invoke OrchestrateTektonPipelineRuns resumes the runs left in progress at the deadline`, func() {
+		outcomes["build"] = "running"
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		options := cdTektonPipelineService.NewOrchestrateTektonPipelineRunsOptions(promotion()).
+			SetStateFile(stateFile).
+			SetPollInterval(time.Millisecond)
+		state, err := cdTektonPipelineService.OrchestrateTektonPipelineRunsWithContext(ctx, options)
+		Expect(err).ToNot(BeNil())
+		Expect(state.Nodes["build"].Status).To(Equal(cdtektonpipelinev2.PipelineRunDAGNodeStatusRunningConst))
+		Expect(store.requestLog()).ToNot(ContainElement(HaveSuffix("/cancel")))
+
+		store.setStatus(state.Nodes["build"].RunID, "succeeded")
+		resumed, err := cdTektonPipelineService.OrchestrateTektonPipelineRuns(options)
+		Expect(err).To(BeNil())
+		Expect(resumed.Succeeded()).To(BeTrue())
+		Expect(resumed.Nodes["build"].Attempt).To(Equal(1))
+		Expect(createdRuns()).To(Equal([]string{"build-pipeline", "test-pipeline", "build-pipeline", "deploy-pipeline", "deploy-pipeline"}))
+	})
+	It(`Invoke OrchestrateTektonPipelineRuns cancels the runs that time out`, func() {
+		outcomes["docs"] = "running"
+		options := cdTektonPipelineService.NewOrchestrateTektonPipelineRunsOptions(promotion()).
+			SetStateFile(stateFile).
+			SetPollInterval(time.Millisecond).
+			SetRunTimeout(50 * time.Millisecond)
+		state, err := cdTektonPipelineService.OrchestrateTektonPipelineRuns(options)
+		Expect(err).To(BeNil())
+		Expect(state.Nodes["docs"].Status).To(Equal(cdtektonpipelinev2.PipelineRunDAGNodeStatusFailedConst))
+		Expect(state.Nodes["docs"].Error).To(Equal("the run timed out"))
+		Expect(store.requestLog()).To(ContainElement("POST /tekton_pipelines/build-pipeline/pipeline_runs/" + state.Nodes["docs"].RunID + "/cancel"))
+		Expect(state.Nodes["prod"].Status).To(Equal(cdtektonpipelinev2.PipelineRunDAGNodeStatusSucceededConst))
+	})
+	It(`Invoke OrchestrateTektonPipelineRuns waits again after a transient error`, func() {
+		failGets := 2
+		store.intercept(func(res http.ResponseWriter, req *http.Request) bool {
+			if failGets == 0 || req.Method != "GET" || strings.Count(strings.Trim(req.URL.Path, "/"), "/") != 3 {
+				return false
+			}
+			failGets--
+			writeMockError(res, 503, "unavailable")
+			return true
+		})
+		options := cdTektonPipelineService.NewOrchestrateTektonPipelineRunsOptions(promotion()).
+			SetPollInterval(time.Millisecond)
+		state, err := cdTektonPipelineService.OrchestrateTektonPipelineRuns(options)
+		Expect(err).To(BeNil())
+		Expect(state.Succeeded()).To(BeTrue())
+		Expect(state.Nodes["build"].Attempt).To(Equal(1))
+		Expect(createdRuns()).To(HaveLen(5))
+	})
+	It(`Invoke OrchestrateTektonPipelineRuns with fail fast`, func() {
+		outcomes["test"] = "running"
+		outcomes["docs"] = "error"
+		options := cdTektonPipelineService.NewOrchestrateTektonPipelineRunsOptions(promotion()).
+			SetFailFast(true).
+			SetPollInterval(time.Millisecond)
+		state, err := cdTektonPipelineService.OrchestrateTektonPipelineRuns(options)
+		Expect(err).To(BeNil())
+		Expect(state.Nodes["docs"].Status).To(Equal(cdtektonpipelinev2.PipelineRunDAGNodeStatusFailedConst))
+		Expect(state.Nodes["test"].Status).To(Equal(cdtektonpipelinev2.PipelineRunDAGNodeStatusCancelledConst))
+		Expect(state.Nodes["staging"].Status).To(Equal(cdtektonpipelinev2.PipelineRunDAGNodeStatusSkippedConst))
+		Expect(store.requestLog()).To(ContainElement("POST /tekton_pipelines/test-pipeline/pipeline_runs/new-2/cancel"))
+	})
+	It(`Invoke OrchestrateTektonPipelineRuns with error: Operation validation and request error`, func() {
+		_, err := cdTektonPipelineService.OrchestrateTektonPipelineRuns(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.OrchestrateTektonPipelineRuns(cdTektonPipelineService.NewOrchestrateTektonPipelineRunsOptions(nil))
+		Expect(err).ToNot(BeNil())
+
+		state := &cdtektonpipelinev2.PipelineRunDAGState{ID: "id", Nodes: map[string]*cdtektonpipelinev2.PipelineRunDAGNodeState{"removed": {}}}
+		Expect(state.Save(stateFile)).To(Succeed())
+		_, err = cdTektonPipelineService.OrchestrateTektonPipelineRuns(
+			cdTektonPipelineService.NewOrchestrateTektonPipelineRunsOptions(promotion()).SetStateFile(stateFile))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("unknown node 'removed'"))
+	})
+})
@@ -0,0 +1,588 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/mail"
+	"strings"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+)
+
+// PipelineRunEventParams : The decoded event that started a pipeline run. Exactly one of Git, Manual and Timer is set
+// according to Kind, except for runs of generic triggers, where Generic is always set and Git is also set when the
+// webhook body is a recognized Git event.
+type PipelineRunEventParams struct {
+	// The kind of event.
+	Kind string `json:"kind"`
+
+	// The Git push or pull request event.
+	Git *GitEventParams `json:"git,omitempty"`
+
+	// The properties passed to a manual run.
+	Manual *ManualEventParams `json:"manual,omitempty"`
+
+	// The body and headers of a generic webhook.
+	Generic *GenericEventParams `json:"generic,omitempty"`
+
+	// The schedule of a timer run.
+	Timer *TimerEventParams `json:"timer,omitempty"`
+
+	// The undecoded event parameters.
+	Raw map[string]interface{} `json:"raw,omitempty"`
+
+	// The undecoded event parameters when they are not a JSON object, such as an array or a string. Raw is then empty.
+	RawValue interface{} `json:"raw_value,omitempty"`
+}
+
+// Constants associated with the PipelineRunEventParams.Kind property.
+// The kind of event.
+const (
+	PipelineRunEventParamsKindGenericConst     = "generic"
+	PipelineRunEventParamsKindGitPushConst     = "git_push"
+	PipelineRunEventParamsKindManualConst      = "manual"
+	PipelineRunEventParamsKindPullRequestConst = "pull_request"
+	PipelineRunEventParamsKindTimerConst       = "timer"
+	PipelineRunEventParamsKindUnknownConst     = "unknown"
+)
+
+// GitEventParams : A Git push or pull request event. GitLab merge requests are reported as pull requests.
+type GitEventParams struct {
+	// The Git provider that sent the event.
+	Provider string `json:"provider"`
+
+	// The full name of the repository, such as `owner/repo`.
+	Repository string `json:"repository,omitempty"`
+
+	// The web URL of the repository.
+	RepositoryURL string `json:"repository_url,omitempty"`
+
+	// The pushed branch, or the source branch of a pull request.
+	Branch string `json:"branch,omitempty"`
+
+	// The pushed tag.
+	Tag string `json:"tag,omitempty"`
+
+	// The head commit SHA.
+	CommitSHA string `json:"commit_sha,omitempty"`
+
+	// The message of the head commit.
+	CommitMessage string `json:"commit_message,omitempty"`
+
+	// The author of the head commit, or of the pull request when the commit author is not part of the event.
+	AuthorName  string `json:"author_name,omitempty"`
+	AuthorEmail string `json:"author_email,omitempty"`
+
+	// The account that sent the event.
+	SenderUsername string `json:"sender_username,omitempty"`
+
+	// The pull request number.
+	PullRequestNumber int64 `json:"pull_request_number,omitempty"`
+
+	// The pull request title.
+	PullRequestTitle string `json:"pull_request_title,omitempty"`
+
+	// The web URL of the pull request.
+	PullRequestURL string `json:"pull_request_url,omitempty"`
+
+	// The pull request action, such as `opened` or `synchronize`.
+	PullRequestAction string `json:"pull_request_action,omitempty"`
+
+	// The target branch of the pull request.
+	TargetBranch string `json:"target_branch,omitempty"`
+}
+
+// Constants associated with the GitEventParams.Provider property.
+// The Git provider that sent the event.
+const (
+	GitEventParamsProviderBitbucketConst = "bitbucket"
+	GitEventParamsProviderGithubConst    = "github"
+	GitEventParamsProviderGitlabConst    = "gitlab"
+)
+
+// ManualEventParams : The properties passed to a manual run, split between overrides of trigger properties and added
+// properties.
+type ManualEventParams struct {
+	// Properties overriding a property defined on the trigger.
+	Overrides map[string]interface{} `json:"overrides,omitempty"`
+
+	// Properties not defined on the trigger.
+	Added map[string]interface{} `json:"added,omitempty"`
+}
+
+// GenericEventParams : The body and headers of a generic webhook.
+type GenericEventParams struct {
+	// The webhook body.
+	Body map[string]interface{} `json:"body,omitempty"`
+
+	// The webhook headers.
+	Headers http.Header `json:"headers,omitempty"`
+}
+
+// TimerEventParams : The schedule of a timer run.
+type TimerEventParams struct {
+	// The cron expression of the trigger.
+	Cron string `json:"cron,omitempty"`
+
+	// The timezone of the cron expression.
+	Timezone string `json:"timezone,omitempty"`
+
+	// When the timer fired, which is the creation time of the run.
+	FiredAt *strfmt.DateTime `json:"fired_at,omitempty"`
+}
+
+// DecodeTriggerHeaders decodes the headers of the request that started the run. Header values may be encoded either as
+// strings or as arrays of strings.
+func (pipelineRun *PipelineRun) DecodeTriggerHeaders() (headers http.Header, err error) {
+	headers = make(http.Header)
+	if pipelineRun.TriggerHeaders == nil || strings.TrimSpace(*pipelineRun.TriggerHeaders) == "" {
+		return
+	}
+	var raw map[string]json.RawMessage
+	if err = json.Unmarshal([]byte(*pipelineRun.TriggerHeaders), &raw); err != nil {
+		err = core.SDKErrorf(err, "", "trigger-headers-error", common.GetComponentInfo())
+		return
+	}
+	for name, value := range raw {
+		var values []string
+		if json.Unmarshal(value, &values) != nil {
+			var single string
+			if err = json.Unmarshal(value, &single); err != nil {
+				err = core.SDKErrorf(err, "", "trigger-headers-error", common.GetComponentInfo())
+				return
+			}
+			values = []string{single}
+		}
+		for _, single := range values {
+			headers.Add(name, single)
+		}
+	}
+	return
+}
+
+// DecodeEventParams decodes the event parameters of the run according to the type of its trigger and to the shape of
+// the event. Events that are not recognized are reported with the PipelineRunEventParamsKindUnknownConst kind and
+// only their raw parameters. Event parameters that are valid JSON but not an object are only set in RawValue.
+func (pipelineRun *PipelineRun) DecodeEventParams() (result *PipelineRunEventParams, err error) {
+	headers, err := pipelineRun.DecodeTriggerHeaders()
+	if err != nil {
+		return
+	}
+
+	var blob []byte
+	result = &PipelineRunEventParams{Kind: PipelineRunEventParamsKindUnknownConst, Raw: map[string]interface{}{}}
+	if pipelineRun.EventParamsBlob != nil && strings.TrimSpace(*pipelineRun.EventParamsBlob) != "" {
+		blob = []byte(*pipelineRun.EventParamsBlob)
+		var value interface{}
+		if err = json.Unmarshal(blob, &value); err != nil {
+			result = nil
+			err = core.SDKErrorf(err, "", "event-params-error", common.GetComponentInfo())
+			return
+		}
+		if object, ok := value.(map[string]interface{}); ok {
+			result.Raw = object
+		} else {
+			// Git events are objects: the event is not decoded further.
+			result.RawValue = value
+			blob = nil
+		}
+	}
+
+	trigger, _ := pipelineRun.Trigger.(*Trigger)
+	triggerType := ""
+	if trigger != nil {
+		triggerType = core.StringNilMapper(trigger.Type)
+	}
+
+	switch triggerType {
+	case CreateTektonPipelineTriggerOptionsTypeTimerConst:
+		result.Kind = PipelineRunEventParamsKindTimerConst
+		result.Timer = &TimerEventParams{
+			Cron:     core.StringNilMapper(trigger.Cron),
+			Timezone: core.StringNilMapper(trigger.Timezone),
+			FiredAt:  pipelineRun.CreatedAt,
+		}
+		return
+	case CreateTektonPipelineTriggerOptionsTypeManualConst:
+		result.Kind = PipelineRunEventParamsKindManualConst
+		result.Manual = decodeManualEvent(trigger, result.Raw)
+		return
+	case CreateTektonPipelineTriggerOptionsTypeGenericConst:
+		result.Kind = PipelineRunEventParamsKindGenericConst
+		result.Generic = &GenericEventParams{Body: result.Raw, Headers: headers}
+	}
+
+	if git, kind := decodeGitEvent(headers, blob, result.Raw); git != nil {
+		result.Kind = kind
+		result.Git = git
+	}
+	return
+}
+
+// decodeManualEvent splits the properties passed to a manual run between overrides and added properties.
+func decodeManualEvent(trigger *Trigger, raw map[string]interface{}) *ManualEventParams {
+	properties, _ := raw["trigger_properties"].(map[string]interface{})
+	if properties == nil {
+		properties, _ = raw["properties"].(map[string]interface{})
+	}
+
+	defined := make(map[string]bool)
+	for _, property := range trigger.Properties {
+		defined[core.StringNilMapper(property.Name)] = true
+	}
+	manual := &ManualEventParams{}
+	for name, value := range properties {
+		if defined[name] {
+			if manual.Overrides == nil {
+				manual.Overrides = make(map[string]interface{})
+			}
+			manual.Overrides[name] = value
+		} else {
+			if manual.Added == nil {
+				manual.Added = make(map[string]interface{})
+			}
+			manual.Added[name] = value
+		}
+	}
+	return manual
+}
+
+// decodeGitEvent recognizes GitHub, GitLab and Bitbucket push and pull request events, first from the event headers
+// and then from the shape of the payload.
+func decodeGitEvent(headers http.Header, blob []byte, raw map[string]interface{}) (git *GitEventParams, kind string) {
+	if len(blob) == 0 {
+		return
+	}
+	switch event := headers.Get("X-GitHub-Event"); {
+	case event == "push":
+		return decodeGithubPush(blob)
+	case event == "pull_request":
+		return decodeGithubPullRequest(blob)
+	}
+	if headers.Get("X-Gitlab-Event") != "" || raw["object_kind"] != nil {
+		return decodeGitlabEvent(blob)
+	}
+	switch event := headers.Get("X-Event-Key"); {
+	case event == "repo:push":
+		return decodeBitbucketPush(blob)
+	case strings.HasPrefix(event, "pullrequest:"):
+		return decodeBitbucketPullRequest(blob, event)
+	}
+
+	switch {
+	case raw["pullrequest"] != nil:
+		return decodeBitbucketPullRequest(blob, "")
+	case raw["push"] != nil && raw["actor"] != nil:
+		return decodeBitbucketPush(blob)
+	case raw["pull_request"] != nil:
+		return decodeGithubPullRequest(blob)
+	case raw["ref"] != nil && raw["repository"] != nil:
+		return decodeGithubPush(blob)
+	}
+	return
+}
+
+// setGitRef sets the branch or tag of an event from a fully qualified Git reference.
+func (git *GitEventParams) setGitRef(ref string) {
+	if tag, ok := strings.CutPrefix(ref, "refs/tags/"); ok {
+		git.Tag = tag
+	} else {
+		git.Branch = strings.TrimPrefix(ref, "refs/heads/")
+	}
+}
+
+type githubRepository struct {
+	FullName string `json:"full_name"`
+	HTMLURL  string `json:"html_url"`
+}
+
+type githubUser struct {
+	Login string `json:"login"`
+}
+
+func decodeGithubPush(blob []byte) (*GitEventParams, string) {
+	var payload struct {
+		Ref        string `json:"ref"`
+		After      string `json:"after"`
+		HeadCommit *struct {
+			ID      string `json:"id"`
+			Message string `json:"message"`
+			Author  struct {
+				Name  string `json:"name"`
+				Email string `json:"email"`
+			} `json:"author"`
+		} `json:"head_commit"`
+		Repository githubRepository `json:"repository"`
+		Sender     githubUser       `json:"sender"`
+	}
+	if json.Unmarshal(blob, &payload) != nil {
+		return nil, ""
+	}
+	git := &GitEventParams{
+		Provider:       GitEventParamsProviderGithubConst,
+		Repository:     payload.Repository.FullName,
+		RepositoryURL:  payload.Repository.HTMLURL,
+		CommitSHA:      payload.After,
+		SenderUsername: payload.Sender.Login,
+	}
+	git.setGitRef(payload.Ref)
+	if payload.HeadCommit != nil {
+		git.CommitSHA = payload.HeadCommit.ID
+		git.CommitMessage = payload.HeadCommit.Message
+		git.AuthorName = payload.HeadCommit.Author.Name
+		git.AuthorEmail = payload.HeadCommit.Author.Email
+	}
+	return git, PipelineRunEventParamsKindGitPushConst
+}
+
+func decodeGithubPullRequest(blob []byte) (*GitEventParams, string) {
+	var payload struct {
+		Action      string `json:"action"`
+		Number      int64  `json:"number"`
+		PullRequest struct {
+			Title   string     `json:"title"`
+			HTMLURL string     `json:"html_url"`
+			User    githubUser `json:"user"`
+			Head    struct {
+				Ref string `json:"ref"`
+				SHA string `json:"sha"`
+			} `json:"head"`
+			Base struct {
+				Ref string `json:"ref"`
+			} `json:"base"`
+		} `json:"pull_request"`
+		Repository githubRepository `json:"repository"`
+		Sender     githubUser       `json:"sender"`
+	}
+	if json.Unmarshal(blob, &payload) != nil {
+		return nil, ""
+	}
+	return &GitEventParams{
+		Provider:          GitEventParamsProviderGithubConst,
+		Repository:        payload.Repository.FullName,
+		RepositoryURL:     payload.Repository.HTMLURL,
+		Branch:            payload.PullRequest.Head.Ref,
+		CommitSHA:         payload.PullRequest.Head.SHA,
+		AuthorName:        payload.PullRequest.User.Login,
+		SenderUsername:    payload.Sender.Login,
+		PullRequestNumber: payload.Number,
+		PullRequestTitle:  payload.PullRequest.Title,
+		PullRequestURL:    payload.PullRequest.HTMLURL,
+		PullRequestAction: payload.Action,
+		TargetBranch:      payload.PullRequest.Base.Ref,
+	}, PipelineRunEventParamsKindPullRequestConst
+}
+
+type gitlabCommit struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
+	Author  struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	} `json:"author"`
+}
+
+func decodeGitlabEvent(blob []byte) (*GitEventParams, string) {
+	var payload struct {
+		ObjectKind   string         `json:"object_kind"`
+		Ref          string         `json:"ref"`
+		CheckoutSHA  string         `json:"checkout_sha"`
+		After        string         `json:"after"`
+		UserName     string         `json:"user_name"`
+		UserUsername string         `json:"user_username"`
+		UserEmail    string         `json:"user_email"`
+		Commits      []gitlabCommit `json:"commits"`
+		User         struct {
+			Name     string `json:"name"`
+			Username string `json:"username"`
+			Email    string `json:"email"`
+		} `json:"user"`
+		Project struct {
+			PathWithNamespace string `json:"path_with_namespace"`
+			WebURL            string `json:"web_url"`
+		} `json:"project"`
+		ObjectAttributes struct {
+			IID          int64        `json:"iid"`
+			Title        string       `json:"title"`
+			URL          string       `json:"url"`
+			Action       string       `json:"action"`
+			SourceBranch string       `json:"source_branch"`
+			TargetBranch string       `json:"target_branch"`
+			LastCommit   gitlabCommit `json:"last_commit"`
+		} `json:"object_attributes"`
+	}
+	if json.Unmarshal(blob, &payload) != nil {
+		return nil, ""
+	}
+	git := &GitEventParams{
+		Provider:      GitEventParamsProviderGitlabConst,
+		Repository:    payload.Project.PathWithNamespace,
+		RepositoryURL: payload.Project.WebURL,
+	}
+
+	switch payload.ObjectKind {
+	case "push", "tag_push":
+		git.setGitRef(payload.Ref)
+		git.CommitSHA = payload.CheckoutSHA
+		if git.CommitSHA == "" {
+			git.CommitSHA = payload.After
+		}
+		git.AuthorName = payload.UserName
+		git.AuthorEmail = payload.UserEmail
+		git.SenderUsername = payload.UserUsername
+		for _, commit := range payload.Commits {
+			if commit.ID == git.CommitSHA {
+				git.CommitMessage = commit.Message
+				git.AuthorName = commit.Author.Name
+				git.AuthorEmail = commit.Author.Email
+			}
+		}
+		return git, PipelineRunEventParamsKindGitPushConst
+	case "merge_request":
+		attributes := payload.ObjectAttributes
+		git.Branch = attributes.SourceBranch
+		git.TargetBranch = attributes.TargetBranch
+		git.CommitSHA = attributes.LastCommit.ID
+		git.CommitMessage = attributes.LastCommit.Message
+		git.AuthorName = attributes.LastCommit.Author.Name
+		git.AuthorEmail = attributes.LastCommit.Author.Email
+		git.SenderUsername = payload.User.Username
+		git.PullRequestNumber = attributes.IID
+		git.PullRequestTitle = attributes.Title
+		git.PullRequestURL = attributes.URL
+		git.PullRequestAction = attributes.Action
+		return git, PipelineRunEventParamsKindPullRequestConst
+	}
+	return nil, ""
+}
+
+type bitbucketUser struct {
+	DisplayName string `json:"display_name"`
+	Nickname    string `json:"nickname"`
+}
+
+type bitbucketLinks struct {
+	HTML struct {
+		Href string `json:"href"`
+	} `json:"html"`
+}
+
+type bitbucketRepository struct {
+	FullName string         `json:"full_name"`
+	Links    bitbucketLinks `json:"links"`
+}
+
+func decodeBitbucketPush(blob []byte) (*GitEventParams, string) {
+	var payload struct {
+		Push struct {
+			Changes []struct {
+				New *struct {
+					Type   string `json:"type"`
+					Name   string `json:"name"`
+					Target struct {
+						Hash    string `json:"hash"`
+						Message string `json:"message"`
+						Author  struct {
+							Raw  string        `json:"raw"`
+							User bitbucketUser `json:"user"`
+						} `json:"author"`
+					} `json:"target"`
+				} `json:"new"`
+			} `json:"changes"`
+		} `json:"push"`
+		Actor      bitbucketUser       `json:"actor"`
+		Repository bitbucketRepository `json:"repository"`
+	}
+	if json.Unmarshal(blob, &payload) != nil {
+		return nil, ""
+	}
+	git := &GitEventParams{
+		Provider:       GitEventParamsProviderBitbucketConst,
+		Repository:     payload.Repository.FullName,
+		RepositoryURL:  payload.Repository.Links.HTML.Href,
+		SenderUsername: payload.Actor.Nickname,
+	}
+	for _, change := range payload.Push.Changes {
+		if change.New == nil {
+			continue
+		}
+		if change.New.Type == "tag" {
+			git.Tag = change.New.Name
+		} else {
+			git.Branch = change.New.Name
+		}
+		git.CommitSHA = change.New.Target.Hash
+		git.CommitMessage = change.New.Target.Message
+		git.AuthorName = change.New.Target.Author.User.DisplayName
+		if address, err := mail.ParseAddress(change.New.Target.Author.Raw); err == nil {
+			git.AuthorEmail = address.Address
+			if git.AuthorName == "" {
+				git.AuthorName = address.Name
+			}
+		}
+		break
+	}
+	return git, PipelineRunEventParamsKindGitPushConst
+}
+
+func decodeBitbucketPullRequest(blob []byte, event string) (*GitEventParams, string) {
+	var payload struct {
+		Pullrequest struct {
+			ID     int64          `json:"id"`
+			Title  string         `json:"title"`
+			Links  bitbucketLinks `json:"links"`
+			Author bitbucketUser  `json:"author"`
+			Source struct {
+				Branch struct {
+					Name string `json:"name"`
+				} `json:"branch"`
+				Commit struct {
+					Hash string `json:"hash"`
+				} `json:"commit"`
+			} `json:"source"`
+			Destination struct {
+				Branch struct {
+					Name string `json:"name"`
+				} `json:"branch"`
+			} `json:"destination"`
+		} `json:"pullrequest"`
+		Actor      bitbucketUser       `json:"actor"`
+		Repository bitbucketRepository `json:"repository"`
+	}
+	if json.Unmarshal(blob, &payload) != nil {
+		return nil, ""
+	}
+	pullRequest := payload.Pullrequest
+	return &GitEventParams{
+		Provider:          GitEventParamsProviderBitbucketConst,
+		Repository:        payload.Repository.FullName,
+		RepositoryURL:     payload.Repository.Links.HTML.Href,
+		Branch:            pullRequest.Source.Branch.Name,
+		CommitSHA:         pullRequest.Source.Commit.Hash,
+		AuthorName:        pullRequest.Author.DisplayName,
+		SenderUsername:    payload.Actor.Nickname,
+		PullRequestNumber: pullRequest.ID,
+		PullRequestTitle:  pullRequest.Title,
+		PullRequestURL:    pullRequest.Links.HTML.Href,
+		PullRequestAction: strings.TrimPrefix(event, "pullrequest:"),
+		TargetBranch:      pullRequest.Destination.Branch.Name,
+	}, PipelineRunEventParamsKindPullRequestConst
+}
@@ -0,0 +1,170 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CdTektonPipelineV2 PipelineRun event params`, func() {
+	newRun := func(triggerType string, blob string, headers string) *cdtektonpipelinev2.PipelineRun {
+		run := &cdtektonpipelinev2.PipelineRun{
+			Trigger:         &cdtektonpipelinev2.Trigger{Type: core.StringPtr(triggerType), Name: core.StringPtr("trigger")},
+			EventParamsBlob: core.StringPtr(blob),
+		}
+		if headers != "" {
+			run.TriggerHeaders = core.StringPtr(headers)
+		}
+		return run
+	}
+
+	DescribeTable(`DecodeEventParams for Git events`,
+		func(blob string, headers string, expectedKind string, expected cdtektonpipelinev2.GitEventParams) {
+			params, err := newRun("scm", blob, headers).DecodeEventParams()
+			Expect(err).To(BeNil())
+			Expect(params.Kind).To(Equal(expectedKind))
+			Expect(params.Git).ToNot(BeNil())
+			Expect(*params.Git).To(Equal(expected))
+		},
+		Entry(`GitHub push`,
+			`{"ref": "refs/heads/main", "after": "abc123", "head_commit": {"id": "abc123", "message": "Fix build", "author": {"name": "Ada", "email": "ada@example.com"}},
+			  "repository": {"full_name": "org/app", "html_url": "https://github.com/org/app"}, "sender": {"login": "ada"}}`,
+			`{"X-GitHub-Event": "push"}`,
+			cdtektonpipelinev2.PipelineRunEventParamsKindGitPushConst,
+			cdtektonpipelinev2.GitEventParams{Provider: "github", Repository: "org/app", RepositoryURL: "https://github.com/org/app",
+				Branch: "main", CommitSHA: "abc123", CommitMessage: "Fix build", AuthorName: "Ada", AuthorEmail: "ada@example.com", SenderUsername: "ada"}),
+		Entry(`GitHub tag push without headers`,
+			`{"ref": "refs/tags/v1.2.0", "after": "def456", "repository": {"full_name": "org/app"}}`,
+			``,
+			cdtektonpipelinev2.PipelineRunEventParamsKindGitPushConst,
+			cdtektonpipelinev2.GitEventParams{Provider: "github", Repository: "org/app", Tag: "v1.2.0", CommitSHA: "def456"}),
+		Entry(`GitHub pull request`,
+			`{"action": "opened", "number": 42, "pull_request": {"title": "Add feature", "html_url": "https://github.com/org/app/pull/42",
+			  "user": {"login": "grace"}, "head": {"ref": "feature", "sha": "fff000"}, "base": {"ref": "main"}}, "repository": {"full_name": "org/app"}, "sender": {"login": "grace"}}`,
+			`{"X-GitHub-Event": ["pull_request"]}`,
+			cdtektonpipelinev2.PipelineRunEventParamsKindPullRequestConst,
+			cdtektonpipelinev2.GitEventParams{Provider: "github", Repository: "org/app", Branch: "feature", CommitSHA: "fff000", AuthorName: "grace",
+				SenderUsername: "grace", PullRequestNumber: 42, PullRequestTitle: "Add feature", PullRequestURL: "https://github.com/org/app/pull/42",
+				PullRequestAction: "opened", TargetBranch: "main"}),
+		Entry(`GitLab push`,
+			`{"object_kind": "push", "ref": "refs/heads/develop", "checkout_sha": "111aaa", "user_name": "Linus", "user_username": "linus", "user_email": "linus@example.com",
+			  "project": {"path_with_namespace": "group/app", "web_url": "https://gitlab.com/group/app"},
+			  "commits": [{"id": "111aaa", "message": "Update docs", "author": {"name": "Margaret", "email": "margaret@example.com"}}]}`,
+			`{"X-Gitlab-Event": "Push Hook"}`,
+			cdtektonpipelinev2.PipelineRunEventParamsKindGitPushConst,
+			cdtektonpipelinev2.GitEventParams{Provider: "gitlab", Repository: "group/app", RepositoryURL: "https://gitlab.com/group/app", Branch: "develop",
+				CommitSHA: "111aaa", CommitMessage: "Update docs", AuthorName: "Margaret", AuthorEmail: "margaret@example.com", SenderUsername: "linus"}),
+		Entry(`GitLab merge request`,
+			`{"object_kind": "merge_request", "user": {"username": "linus"}, "project": {"path_with_namespace": "group/app"},
+			  "object_attributes": {"iid": 7, "title": "Refactor", "url": "https://gitlab.com/group/app/-/merge_requests/7", "action": "update",
+			  "source_branch": "refactor", "target_branch": "main", "last_commit": {"id": "222bbb", "message": "Refactor", "author": {"name": "Linus", "email": "linus@example.com"}}}}`,
+			``,
+			cdtektonpipelinev2.PipelineRunEventParamsKindPullRequestConst,
+			cdtektonpipelinev2.GitEventParams{Provider: "gitlab", Repository: "group/app", Branch: "refactor", CommitSHA: "222bbb", CommitMessage: "Refactor",
+				AuthorName: "Linus", AuthorEmail: "linus@example.com", SenderUsername: "linus", PullRequestNumber: 7, PullRequestTitle: "Refactor",
+				PullRequestURL: "https://gitlab.com/group/app/-/merge_requests/7", PullRequestAction: "update", TargetBranch: "main"}),
+		Entry(`Bitbucket push`,
+			`{"push": {"changes": [{"new": {"type": "branch", "name": "main", "target": {"hash": "333ccc", "message": "Release",
+			  "author": {"raw": "Barbara <barbara@example.com>", "user": {"display_name": "Barbara L"}}}}}]},
+			  "actor": {"nickname": "barbara"}, "repository": {"full_name": "team/app", "links": {"html": {"href": "https://bitbucket.org/team/app"}}}}`,
+			`{"X-Event-Key": "repo:push"}`,
+			cdtektonpipelinev2.PipelineRunEventParamsKindGitPushConst,
+			cdtektonpipelinev2.GitEventParams{Provider: "bitbucket", Repository: "team/app", RepositoryURL: "https://bitbucket.org/team/app", Branch: "main",
+				CommitSHA: "333ccc", CommitMessage: "Release", AuthorName: "Barbara L", AuthorEmail: "barbara@example.com", SenderUsername: "barbara"}),
+		Entry(`Bitbucket pull request`,
+			`{"pullrequest": {"id": 3, "title": "Hotfix", "links": {"html": {"href": "https://bitbucket.org/team/app/pull-requests/3"}}, "author": {"display_name": "Barbara L"},
+			  "source": {"branch": {"name": "hotfix"}, "commit": {"hash": "444ddd"}}, "destination": {"branch": {"name": "main"}}},
+			  "actor": {"nickname": "barbara"}, "repository": {"full_name": "team/app"}}`,
+			`{"X-Event-Key": "pullrequest:created"}`,
+			cdtektonpipelinev2.PipelineRunEventParamsKindPullRequestConst,
+			cdtektonpipelinev2.GitEventParams{Provider: "bitbucket", Repository: "team/app", Branch: "hotfix", CommitSHA: "444ddd", AuthorName: "Barbara L",
+				SenderUsername: "barbara", PullRequestNumber: 3, PullRequestTitle: "Hotfix", PullRequestURL: "https://bitbucket.org/team/app/pull-requests/3",
+				PullRequestAction: "created", TargetBranch: "main"}),
+	)
+
+	It(`Invoke DecodeEventParams for manual runs`, func() {
+		run := newRun("manual", `{"trigger_properties": {"env": "prod", "debug": "true"}}`, "")
+		run.Trigger.(*cdtektonpipelinev2.Trigger).Properties = []cdtektonpipelinev2.TriggerProperty{{Name: core.StringPtr("env")}}
+		params, err := run.DecodeEventParams()
+		Expect(err).To(BeNil())
+		Expect(params.Kind).To(Equal(cdtektonpipelinev2.PipelineRunEventParamsKindManualConst))
+		Expect(params.Manual.Overrides).To(Equal(map[string]interface{}{"env": "prod"}))
+		Expect(params.Manual.Added).To(Equal(map[string]interface{}{"debug": "true"}))
+	})
+	It(`Invoke DecodeEventParams for generic webhooks`, func() {
+		params, err := newRun("generic", `{"image": "app:1.0"}`, `{"Content-Type": "application/json", "X-Request-Id": ["a", "b"]}`).DecodeEventParams()
+		Expect(err).To(BeNil())
+		Expect(params.Kind).To(Equal(cdtektonpipelinev2.PipelineRunEventParamsKindGenericConst))
+		Expect(params.Git).To(BeNil())
+		Expect(params.Generic.Body).To(Equal(map[string]interface{}{"image": "app:1.0"}))
+		Expect(params.Generic.Headers.Get("content-type")).To(Equal("application/json"))
+		Expect(params.Generic.Headers.Values("X-Request-Id")).To(Equal([]string{"a", "b"}))
+
+		params, err = newRun("generic", `{"ref": "refs/heads/main", "after": "abc", "repository": {"full_name": "org/app"}}`, "").DecodeEventParams()
+		Expect(err).To(BeNil())
+		Expect(params.Kind).To(Equal(cdtektonpipelinev2.PipelineRunEventParamsKindGitPushConst))
+		Expect(params.Generic).ToNot(BeNil())
+		Expect(params.Git.CommitSHA).To(Equal("abc"))
+	})
+	It(`Invoke DecodeEventParams for timer runs`, func() {
+		run := newRun("timer", `{}`, "")
+		run.Trigger.(*cdtektonpipelinev2.Trigger).Cron = core.StringPtr("0 2 * * *")
+		run.Trigger.(*cdtektonpipelinev2.Trigger).Timezone = core.StringPtr("Europe/Paris")
+		createdAt := strfmt.DateTime(time.Date(2026, 3, 1, 1, 0, 0, 0, time.UTC))
+		run.CreatedAt = &createdAt
+		params, err := run.DecodeEventParams()
+		Expect(err).To(BeNil())
+		Expect(params.Kind).To(Equal(cdtektonpipelinev2.PipelineRunEventParamsKindTimerConst))
+		Expect(*params.Timer).To(Equal(cdtektonpipelinev2.TimerEventParams{Cron: "0 2 * * *", Timezone: "Europe/Paris", FiredAt: &createdAt}))
+	})
+	It(`Invoke DecodeEventParams for unrecognized events`, func() {
+		params, err := newRun("scm", `{"event": "something"}`, "").DecodeEventParams()
+		Expect(err).To(BeNil())
+		Expect(params.Kind).To(Equal(cdtektonpipelinev2.PipelineRunEventParamsKindUnknownConst))
+		Expect(params.Git).To(BeNil())
+		Expect(params.Raw).To(Equal(map[string]interface{}{"event": "something"}))
+
+		params, err = (&cdtektonpipelinev2.PipelineRun{}).DecodeEventParams()
+		Expect(err).To(BeNil())
+		Expect(params.Kind).To(Equal(cdtektonpipelinev2.PipelineRunEventParamsKindUnknownConst))
+
+		params, err = newRun("scm", `["refs/heads/main"]`, `{"X-GitHub-Event": "push"}`).DecodeEventParams()
+		Expect(err).To(BeNil())
+		Expect(params.Kind).To(Equal(cdtektonpipelinev2.PipelineRunEventParamsKindUnknownConst))
+		Expect(params.Raw).To(BeEmpty())
+		Expect(params.RawValue).To(Equal([]interface{}{"refs/heads/main"}))
+
+		params, err = newRun("generic", `"deploy"`, "").DecodeEventParams()
+		Expect(err).To(BeNil())
+		Expect(params.Kind).To(Equal(cdtektonpipelinev2.PipelineRunEventParamsKindGenericConst))
+		Expect(params.RawValue).To(Equal("deploy"))
+	})
+	It(`Invoke DecodeEventParams with error: invalid JSON`, func() {
+		_, err := newRun("scm", `EventParamsBlob`, "").DecodeEventParams()
+		Expect(err).ToNot(BeNil())
+		_, err = newRun("scm", `{}`, `TriggerHeaders`).DecodeEventParams()
+		Expect(err).ToNot(BeNil())
+		_, err = newRun("scm", `{}`, `{"X-Count": 1}`).DecodeTriggerHeaders()
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,402 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultFanOutConcurrency is the number of cells run in parallel by FanOutTektonPipelineRuns when Concurrency is not
+// set.
+const DefaultFanOutConcurrency = 4
+
+// PipelineRunMatrixDimension : One dimension of a run matrix: a trigger property and the values it takes.
+type PipelineRunMatrixDimension struct {
+	// The name of the trigger property.
+	Property *string `json:"property" validate:"required,ne="`
+
+	// The values of the trigger property, one per cell.
+	Values []string `json:"values" validate:"required,min=1"`
+}
+
+// NewPipelineRunMatrixDimension : Instantiate PipelineRunMatrixDimension (Generic Model Constructor)
+func (*CdTektonPipelineV2) NewPipelineRunMatrixDimension(property string, values []string) (_model *PipelineRunMatrixDimension, err error) {
+	_model = &PipelineRunMatrixDimension{
+		Property: core.StringPtr(property),
+		Values:   values,
+	}
+	err = core.ValidateStruct(_model, "required parameters")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-missing-required", common.GetComponentInfo())
+	}
+	return
+}
+
+// FanOutTektonPipelineRunsOptions : The FanOutTektonPipelineRuns options.
+type FanOutTektonPipelineRunsOptions struct {
+	// The Tekton pipeline ID.
+	PipelineID *string `json:"pipeline_id" validate:"required,ne="`
+
+	// Trigger name.
+	TriggerName *string `json:"trigger_name" validate:"required,ne="`
+
+	// The dimensions of the matrix. One run is created per combination of their values.
+	Dimensions []PipelineRunMatrixDimension `json:"dimensions" validate:"required,min=1,dive"`
+
+	// Trigger properties shared by all the runs. The values of the cell override them.
+	TriggerProperties map[string]interface{} `json:"trigger_properties,omitempty"`
+
+	// Secure trigger properties shared by all the runs.
+	SecureTriggerProperties map[string]interface{} `json:"secure_trigger_properties,omitempty"`
+
+	// Optional description of the runs.
+	Description *string `json:"description,omitempty"`
+
+	// Maximum number of runs in progress at the same time. Defaults to DefaultFanOutConcurrency.
+	Concurrency int
+
+	// When true, the first run that does not succeed stops the fan-out: the runs in progress are cancelled and the
+	// cells not started yet are skipped.
+	FailFast bool
+
+	// Delay before the second poll of each run. Defaults to DefaultWaitPollInterval.
+	PollInterval time.Duration
+
+	// Upper bound for the delay between two polls of each run. Defaults to DefaultWaitMaxPollInterval.
+	MaxPollInterval time.Duration
+
+	// Maximum time to wait for each run. When zero, only the deadline of the context applies. The runs that time out
+	// are cancelled, and keep the `timed_out` outcome.
+	RunTimeout time.Duration
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewFanOutTektonPipelineRunsOptions : Instantiate FanOutTektonPipelineRunsOptions
+func (*CdTektonPipelineV2) NewFanOutTektonPipelineRunsOptions(pipelineID string, triggerName string, dimensions []PipelineRunMatrixDimension) *FanOutTektonPipelineRunsOptions {
+	return &FanOutTektonPipelineRunsOptions{
+		PipelineID:  core.StringPtr(pipelineID),
+		TriggerName: core.StringPtr(triggerName),
+		Dimensions:  dimensions,
+	}
+}
+
+// SetPipelineID : Allow user to set PipelineID
+func (_options *FanOutTektonPipelineRunsOptions) SetPipelineID(pipelineID string) *FanOutTektonPipelineRunsOptions {
+	_options.PipelineID = core.StringPtr(pipelineID)
+	return _options
+}
+
+// SetTriggerName : Allow user to set TriggerName
+func (_options *FanOutTektonPipelineRunsOptions) SetTriggerName(triggerName string) *FanOutTektonPipelineRunsOptions {
+	_options.TriggerName = core.StringPtr(triggerName)
+	return _options
+}
+
+// SetDimensions : Allow user to set Dimensions
+func (_options *FanOutTektonPipelineRunsOptions) SetDimensions(dimensions []PipelineRunMatrixDimension) *FanOutTektonPipelineRunsOptions {
+	_options.Dimensions = dimensions
+	return _options
+}
+
+// SetTriggerProperties : Allow user to set TriggerProperties
+func (_options *FanOutTektonPipelineRunsOptions) SetTriggerProperties(triggerProperties map[string]interface{}) *FanOutTektonPipelineRunsOptions {
+	_options.TriggerProperties = triggerProperties
+	return _options
+}
+
+// SetSecureTriggerProperties : Allow user to set SecureTriggerProperties
+func (_options *FanOutTektonPipelineRunsOptions) SetSecureTriggerProperties(secureTriggerProperties map[string]interface{}) *FanOutTektonPipelineRunsOptions {
+	_options.SecureTriggerProperties = secureTriggerProperties
+	return _options
+}
+
+// SetDescription : Allow user to set Description
+func (_options *FanOutTektonPipelineRunsOptions) SetDescription(description string) *FanOutTektonPipelineRunsOptions {
+	_options.Description = core.StringPtr(description)
+	return _options
+}
+
+// SetConcurrency : Allow user to set Concurrency
+func (_options *FanOutTektonPipelineRunsOptions) SetConcurrency(concurrency int) *FanOutTektonPipelineRunsOptions {
+	_options.Concurrency = concurrency
+	return _options
+}
+
+// SetFailFast : Allow user to set FailFast
+func (_options *FanOutTektonPipelineRunsOptions) SetFailFast(failFast bool) *FanOutTektonPipelineRunsOptions {
+	_options.FailFast = failFast
+	return _options
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (_options *FanOutTektonPipelineRunsOptions) SetPollInterval(pollInterval time.Duration) *FanOutTektonPipelineRunsOptions {
+	_options.PollInterval = pollInterval
+	return _options
+}
+
+// SetMaxPollInterval : Allow user to set MaxPollInterval
+func (_options *FanOutTektonPipelineRunsOptions) SetMaxPollInterval(maxPollInterval time.Duration) *FanOutTektonPipelineRunsOptions {
+	_options.MaxPollInterval = maxPollInterval
+	return _options
+}
+
+// SetRunTimeout : Allow user to set RunTimeout
+func (_options *FanOutTektonPipelineRunsOptions) SetRunTimeout(runTimeout time.Duration) *FanOutTektonPipelineRunsOptions {
+	_options.RunTimeout = runTimeout
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *FanOutTektonPipelineRunsOptions) SetHeaders(param map[string]string) *FanOutTektonPipelineRunsOptions {
+	options.Headers = param
+	return options
+}
+
+// PipelineRunFanOutReport : The cells of a matrix run by FanOutTektonPipelineRuns.
+type PipelineRunFanOutReport struct {
+	// One cell per combination of the dimension values. The first dimension varies the slowest.
+	Cells []PipelineRunFanOutCell
+}
+
+// PipelineRunFanOutCell : One combination of the dimension values and the run it triggered.
+type PipelineRunFanOutCell struct {
+	// The combination, formatted as `property=value` pairs in the order of the dimensions.
+	Name string
+
+	// The trigger property values of the combination.
+	Properties map[string]string
+
+	// The last observed state of the run. Nil when the run was not created.
+	Run *PipelineRun
+
+	// The URL of the run. Empty when the run was not created.
+	RunURL string
+
+	// The outcome of the run. Empty when it is not known.
+	Outcome PipelineRunOutcome
+
+	// True when the cell was not started because the fan-out failed fast.
+	Skipped bool
+
+	// The error raised while creating, waiting for or cancelling the run.
+	Error error
+}
+
+// Succeeded returns true when every run of the matrix succeeded.
+func (report *PipelineRunFanOutReport) Succeeded() bool {
+	return len(report.Failed()) == 0
+}
+
+// Failed returns the cells whose run did not succeed, including the skipped cells.
+func (report *PipelineRunFanOutReport) Failed() (failed []PipelineRunFanOutCell) {
+	for _, cell := range report.Cells {
+		if cell.Outcome != PipelineRunOutcomeSucceededConst {
+			failed = append(failed, cell)
+		}
+	}
+	return
+}
+
+// String returns the outcome of each cell, one per line.
+func (report *PipelineRunFanOutReport) String() string {
+	var builder strings.Builder
+	for _, cell := range report.Cells {
+		status := string(cell.Outcome)
+		switch {
+		case cell.Skipped:
+			status = "skipped"
+		case cell.Error != nil:
+			status = "error: " + cell.Error.Error()
+		}
+		fmt.Fprintf(&builder, "%s: %s", cell.Name, status)
+		if cell.RunURL != "" {
+			fmt.Fprintf(&builder, " (%s)", cell.RunURL)
+		}
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
+
+// newPipelineRunFanOutCells returns the cartesian product of the dimension values.
+func newPipelineRunFanOutCells(dimensions []PipelineRunMatrixDimension) []PipelineRunFanOutCell {
+	cells := []PipelineRunFanOutCell{{Properties: map[string]string{}}}
+	for _, dimension := range dimensions {
+		var product []PipelineRunFanOutCell
+		for _, cell := range cells {
+			for _, value := range dimension.Values {
+				properties := make(map[string]string, len(cell.Properties)+1)
+				for name, cellValue := range cell.Properties {
+					properties[name] = cellValue
+				}
+				properties[*dimension.Property] = value
+				name := *dimension.Property + "=" + value
+				if cell.Name != "" {
+					name = cell.Name + "," + name
+				}
+				product = append(product, PipelineRunFanOutCell{Name: name, Properties: properties})
+			}
+		}
+		cells = product
+	}
+	return cells
+}
+
+// FanOutTektonPipelineRuns : Trigger a pipeline run per combination of a matrix of trigger property values
+// This creates one run of the trigger per cell of the matrix, with at most Concurrency runs in progress at the same
+// time, waits for all of them and reports the outcome of each cell. The error is only set when the options are invalid
+// or the context is done; the errors of the cells are reported in the cells. When the context is done, the runs in
+// progress are cancelled and the cells not started yet are skipped.
+func (cdTektonPipeline *CdTektonPipelineV2) FanOutTektonPipelineRuns(fanOutTektonPipelineRunsOptions *FanOutTektonPipelineRunsOptions) (result *PipelineRunFanOutReport, err error) {
+	result, err = cdTektonPipeline.FanOutTektonPipelineRunsWithContext(context.Background(), fanOutTektonPipelineRunsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// FanOutTektonPipelineRunsWithContext is an alternate form of the FanOutTektonPipelineRuns method which supports a Context parameter.
+func (cdTektonPipeline *CdTektonPipelineV2) FanOutTektonPipelineRunsWithContext(ctx context.Context, fanOutTektonPipelineRunsOptions *FanOutTektonPipelineRunsOptions) (result *PipelineRunFanOutReport, err error) {
+	err = core.ValidateNotNil(fanOutTektonPipelineRunsOptions, "fanOutTektonPipelineRunsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(fanOutTektonPipelineRunsOptions, "fanOutTektonPipelineRunsOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	properties := make(map[string]bool)
+	for _, dimension := range fanOutTektonPipelineRunsOptions.Dimensions {
+		if properties[*dimension.Property] {
+			err = core.SDKErrorf(nil, fmt.Sprintf("duplicate matrix dimension '%s'", *dimension.Property), "fan-out-duplicate-dimension", common.GetComponentInfo())
+			return
+		}
+		properties[*dimension.Property] = true
+	}
+
+	concurrency := fanOutTektonPipelineRunsOptions.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultFanOutConcurrency
+	}
+
+	result = &PipelineRunFanOutReport{Cells: newPipelineRunFanOutCells(fanOutTektonPipelineRunsOptions.Dimensions)}
+
+	// stopCtx is cancelled when the fan-out fails fast, which interrupts the waits and the cells not started yet.
+	stopCtx, stop := context.WithCancel(ctx)
+	defer stop()
+
+	// Cells are started in order, as soon as one of the runs in progress ends.
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, concurrency)
+	for index := range result.Cells {
+		select {
+		case semaphore <- struct{}{}:
+		case <-stopCtx.Done():
+		}
+		if stopCtx.Err() != nil {
+			for skipped := index; skipped < len(result.Cells); skipped++ {
+				result.Cells[skipped].Skipped = true
+			}
+			break
+		}
+
+		wg.Add(1)
+		go func(cell *PipelineRunFanOutCell) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			cdTektonPipeline.runFanOutCell(ctx, stopCtx, cell, fanOutTektonPipelineRunsOptions)
+			if fanOutTektonPipelineRunsOptions.FailFast && cell.Outcome != PipelineRunOutcomeSucceededConst {
+				stop()
+			}
+		}(&result.Cells[index])
+	}
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		err = core.SDKErrorf(ctx.Err(), "", "fan-out-cancelled", common.GetComponentInfo())
+	}
+	return
+}
+
+// runFanOutCell creates the run of a cell and waits for it. When ctx or stopCtx is done or the run times out while
+// waiting, the run is cancelled.
+func (cdTektonPipeline *CdTektonPipelineV2) runFanOutCell(ctx context.Context, stopCtx context.Context, cell *PipelineRunFanOutCell, options *FanOutTektonPipelineRunsOptions) {
+	triggerProperties := make(map[string]interface{}, len(options.TriggerProperties)+len(cell.Properties))
+	for name, value := range options.TriggerProperties {
+		triggerProperties[name] = value
+	}
+	for name, value := range cell.Properties {
+		triggerProperties[name] = value
+	}
+
+	run, _, err := cdTektonPipeline.CreateTektonPipelineRunWithContext(ctx, &CreateTektonPipelineRunOptions{
+		PipelineID:              options.PipelineID,
+		TriggerName:             options.TriggerName,
+		TriggerProperties:       triggerProperties,
+		SecureTriggerProperties: options.SecureTriggerProperties,
+		Description:             options.Description,
+		Headers:                 options.Headers,
+	})
+	if err != nil {
+		cell.Error = core.RepurposeSDKProblem(err, "fan-out-create-error")
+		return
+	}
+	cell.Run, cell.RunURL = run, core.StringNilMapper(run.RunURL)
+
+	waited, outcome, err := cdTektonPipeline.WaitForTektonPipelineRunWithContext(stopCtx, &WaitForTektonPipelineRunOptions{
+		PipelineID:      options.PipelineID,
+		ID:              run.ID,
+		PollInterval:    options.PollInterval,
+		MaxPollInterval: options.MaxPollInterval,
+		Timeout:         options.RunTimeout,
+		Headers:         options.Headers,
+	})
+	if waited != nil {
+		cell.Run = waited
+	}
+	cell.Outcome = outcome
+	if err == nil && outcome != PipelineRunOutcomeTimedOutConst {
+		return
+	}
+	if err != nil && stopCtx.Err() == nil {
+		cell.Error = core.RepurposeSDKProblem(err, "fan-out-wait-error")
+		return
+	}
+
+	// ctx may be the one that is done.
+	cancelled, _, err := cdTektonPipeline.CancelTektonPipelineRunWithContext(context.WithoutCancel(ctx), &CancelTektonPipelineRunOptions{
+		PipelineID: options.PipelineID,
+		ID:         run.ID,
+		Headers:    options.Headers,
+	})
+	if err != nil {
+		cell.Error = core.RepurposeSDKProblem(err, "fan-out-cancel-error")
+		return
+	}
+	cell.Run = cancelled
+	if outcome != PipelineRunOutcomeTimedOutConst {
+		cell.Outcome = PipelineRunOutcomeCancelledConst
+	}
+}
@@ -0,0 +1,179 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CdTektonPipelineV2 FanOutTektonPipelineRuns`, func() {
+	var store *mockRunStore
+	var testServer *httptest.Server
+	var cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+	var dimensions []cdtektonpipelinev2.PipelineRunMatrixDimension
+
+	BeforeEach(func() {
+		store = newMockRunStore()
+		// The status of a created run is given by its `outcome` trigger property, defaulting to succeeded.
+		store.onCreate = func(pipelineID string, run *mockRun) {
+			run.Status = "succeeded"
+			if outcome, ok := run.Properties["outcome"].(string); ok {
+				run.Status = outcome
+			}
+		}
+		testServer = store.server()
+		var serviceErr error
+		cdTektonPipelineService, serviceErr = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+
+		region, err := cdTektonPipelineService.NewPipelineRunMatrixDimension("region", []string{"us-south", "eu-de", "jp-tok"})
+		Expect(err).To(BeNil())
+		arch, err := cdTektonPipelineService.NewPipelineRunMatrixDimension("arch", []string{"amd64", "s390x"})
+		Expect(err).To(BeNil())
+		dimensions = []cdtektonpipelinev2.PipelineRunMatrixDimension{*region, *arch}
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Invoke FanOutTektonPipelineRuns successfully`, func() {
+		options := cdTektonPipelineService.NewFanOutTektonPipelineRunsOptions("PipelineID", "deploy", dimensions).
+			SetTriggerProperties(map[string]interface{}{"version": "1.2.3", "arch": "ignored"}).
+			SetConcurrency(2).
+			SetPollInterval(time.Millisecond)
+		report, err := cdTektonPipelineService.FanOutTektonPipelineRuns(options)
+		Expect(err).To(BeNil())
+		Expect(report.Succeeded()).To(BeTrue())
+		Expect(report.Cells).To(HaveLen(6))
+		Expect(report.Cells[0].Name).To(Equal("region=us-south,arch=amd64"))
+		Expect(report.Cells[5].Name).To(Equal("region=jp-tok,arch=s390x"))
+		Expect(report.Cells[1].Properties).To(Equal(map[string]string{"region": "us-south", "arch": "s390x"}))
+		for _, cell := range report.Cells {
+			Expect(cell.Outcome).To(Equal(cdtektonpipelinev2.PipelineRunOutcomeSucceededConst))
+			Expect(cell.RunURL).To(HavePrefix("https://cloud.ibm.com/devops/pipelines/tekton/PipelineID/runs/new-"))
+		}
+
+		store.mutex.Lock()
+		defer store.mutex.Unlock()
+		Expect(store.runs["PipelineID"]).To(HaveLen(6))
+		for _, run := range store.runs["PipelineID"] {
+			Expect(run.Trigger).To(Equal("deploy"))
+			Expect(run.Properties).To(HaveKeyWithValue("version", "1.2.3"))
+			Expect(run.Properties["arch"]).To(BeElementOf("amd64", "s390x"))
+		}
+	})
+	It(`Invoke FanOutTektonPipelineRuns reports the failed cells`, func() {
+		outcome, err := cdTektonPipelineService.NewPipelineRunMatrixDimension("outcome", []string{"succeeded", "failed"})
+		Expect(err).To(BeNil())
+		options := cdTektonPipelineService.NewFanOutTektonPipelineRunsOptions("PipelineID", "deploy",
+			[]cdtektonpipelinev2.PipelineRunMatrixDimension{dimensions[0], *outcome}).SetPollInterval(time.Millisecond)
+		report, err := cdTektonPipelineService.FanOutTektonPipelineRuns(options)
+		Expect(err).To(BeNil())
+		Expect(report.Succeeded()).To(BeFalse())
+		Expect(report.Failed()).To(HaveLen(3))
+		Expect(report.Cells[1].Outcome).To(Equal(cdtektonpipelinev2.PipelineRunOutcomeFailedConst))
+		Expect(strings.Split(report.String(), "\n")[1]).To(HavePrefix("region=us-south,outcome=failed: failed (https://"))
+	})
+	It(`Invoke FanOutTektonPipelineRuns with fail fast`, func() {
+		outcome, err := cdTektonPipelineService.NewPipelineRunMatrixDimension("outcome", []string{"running", "failed", "succeeded", "succeeded"})
+		Expect(err).To(BeNil())
+		options := cdTektonPipelineService.NewFanOutTektonPipelineRunsOptions("PipelineID", "deploy",
+			[]cdtektonpipelinev2.PipelineRunMatrixDimension{*outcome}).
+			SetConcurrency(2).
+			SetFailFast(true).
+			SetPollInterval(time.Millisecond)
+		report, err := cdTektonPipelineService.FanOutTektonPipelineRuns(options)
+		Expect(err).To(BeNil())
+		Expect(report.Cells[0].Outcome).To(Equal(cdtektonpipelinev2.PipelineRunOutcomeCancelledConst))
+		Expect(*report.Cells[0].Run.Status).To(Equal("cancelled"))
+		Expect(report.Cells[1].Outcome).To(Equal(cdtektonpipelinev2.PipelineRunOutcomeFailedConst))
+		Expect(report.Cells[2].Skipped).To(BeTrue())
+		Expect(report.Cells[3].Skipped).To(BeTrue())
+		Expect(report.Cells[3].Run).To(BeNil())
+		Expect(report.Failed()).To(HaveLen(4))
+		Expect(report.String()).To(ContainSubstring("outcome=succeeded: skipped\n"))
+	})
+	It(`Invoke FanOutTektonPipelineRuns cancels the runs that time out`, func() {
+		outcome, err := cdTektonPipelineService.NewPipelineRunMatrixDimension("outcome", []string{"running", "succeeded"})
+		Expect(err).To(BeNil())
+		options := cdTektonPipelineService.NewFanOutTektonPipelineRunsOptions("PipelineID", "deploy",
+			[]cdtektonpipelinev2.PipelineRunMatrixDimension{*outcome}).
+			SetRunTimeout(20 * time.Millisecond).
+			SetPollInterval(time.Millisecond)
+		report, err := cdTektonPipelineService.FanOutTektonPipelineRuns(options)
+		Expect(err).To(BeNil())
+		Expect(report.Cells[0].Outcome).To(Equal(cdtektonpipelinev2.PipelineRunOutcomeTimedOutConst))
+		Expect(report.Cells[0].Error).To(BeNil())
+		Expect(*report.Cells[0].Run.Status).To(Equal("cancelled"))
+		Expect(report.Cells[1].Outcome).To(Equal(cdtektonpipelinev2.PipelineRunOutcomeSucceededConst))
+		Expect(store.requestLog()).To(ContainElement("POST /tekton_pipelines/PipelineID/pipeline_runs/" + *report.Cells[0].Run.ID + "/cancel"))
+	})
+	It(`Invoke FanOutTektonPipelineRuns cancels the runs in progress when the context is cancelled`, func() {
+		outcome, err := cdTektonPipelineService.NewPipelineRunMatrixDimension("outcome", []string{"running", "running"})
+		Expect(err).To(BeNil())
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		// The context is cancelled once the first run is being waited for.
+		store.intercept(func(res http.ResponseWriter, req *http.Request) bool {
+			if req.Method == "GET" && strings.HasSuffix(req.URL.Path, "/pipeline_runs/new-1") {
+				cancel()
+			}
+			return false
+		})
+		options := cdTektonPipelineService.NewFanOutTektonPipelineRunsOptions("PipelineID", "deploy",
+			[]cdtektonpipelinev2.PipelineRunMatrixDimension{*outcome}).
+			SetConcurrency(1).
+			SetPollInterval(time.Millisecond)
+		report, err := cdTektonPipelineService.FanOutTektonPipelineRunsWithContext(ctx, options)
+		Expect(err).ToNot(BeNil())
+		Expect(report.Cells[0].Outcome).To(Equal(cdtektonpipelinev2.PipelineRunOutcomeCancelledConst))
+		Expect(report.Cells[0].Error).To(BeNil())
+		Expect(*report.Cells[0].Run.Status).To(Equal("cancelled"))
+		Expect(report.Cells[1].Skipped).To(BeTrue())
+		Expect(store.requestLog()).To(ContainElement("POST /tekton_pipelines/PipelineID/pipeline_runs/new-1/cancel"))
+	})
+	It(`Invoke FanOutTektonPipelineRuns with error: Operation validation and request error`, func() {
+		_, err := cdTektonPipelineService.FanOutTektonPipelineRuns(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.FanOutTektonPipelineRuns(
+			cdTektonPipelineService.NewFanOutTektonPipelineRunsOptions("PipelineID", "deploy", nil))
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.FanOutTektonPipelineRuns(
+			cdTektonPipelineService.NewFanOutTektonPipelineRunsOptions("PipelineID", "deploy", append(dimensions, dimensions[0])))
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.NewPipelineRunMatrixDimension("region", nil)
+		Expect(err).ToNot(BeNil())
+
+		report, err := cdTektonPipelineService.FanOutTektonPipelineRuns(
+			cdTektonPipelineService.NewFanOutTektonPipelineRunsOptions("unknown/pipeline", "deploy", dimensions))
+		Expect(err).To(BeNil())
+		Expect(report.Failed()).To(HaveLen(6))
+		Expect(report.Cells[0].Error).ToNot(BeNil())
+	})
+})
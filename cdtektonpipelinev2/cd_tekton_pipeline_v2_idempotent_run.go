@@ -0,0 +1,227 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultIdempotencySearchLimit is the number of recent runs searched for an idempotency key by
+// CreateTektonPipelineRunIdempotent when SearchLimit is not set.
+const DefaultIdempotencySearchLimit = 100
+
+// idempotencyKeyPattern matches the valid idempotency keys.
+var idempotencyKeyPattern = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// idempotencyKeyMarkerPattern matches the idempotency key marker embedded in a run description.
+var idempotencyKeyMarkerPattern = regexp.MustCompile(`\[idempotency-key:([A-Za-z0-9._:-]{1,128})\]`)
+
+// idempotencyKeyMarker returns the marker embedding an idempotency key in a run description.
+func idempotencyKeyMarker(key string) string {
+	return "[idempotency-key:" + key + "]"
+}
+
+// PipelineRunIdempotencyKey returns the idempotency key embedded in the description of a run by
+// CreateTektonPipelineRunIdempotent, or an empty string if the run has none.
+func PipelineRunIdempotencyKey(run *PipelineRun) string {
+	if match := idempotencyKeyMarkerPattern.FindStringSubmatch(core.StringNilMapper(run.Description)); match != nil {
+		return match[1]
+	}
+	return ""
+}
+
+// CreateTektonPipelineRunIdempotentOptions : The CreateTektonPipelineRunIdempotent options.
+type CreateTektonPipelineRunIdempotentOptions struct {
+	// The options of the run to create. They are not modified.
+	CreateTektonPipelineRunOptions *CreateTektonPipelineRunOptions `json:"create_tekton_pipeline_run_options" validate:"required"`
+
+	// The idempotency key, made of 1 to 128 letters, digits, `.`, `_`, `:` or `-`.
+	IdempotencyKey *string `json:"idempotency_key" validate:"required,ne="`
+
+	// Number of recent runs searched for the key. Defaults to DefaultIdempotencySearchLimit.
+	SearchLimit int
+}
+
+// NewCreateTektonPipelineRunIdempotentOptions : Instantiate CreateTektonPipelineRunIdempotentOptions
+func (*CdTektonPipelineV2) NewCreateTektonPipelineRunIdempotentOptions(createTektonPipelineRunOptions *CreateTektonPipelineRunOptions, idempotencyKey string) *CreateTektonPipelineRunIdempotentOptions {
+	return &CreateTektonPipelineRunIdempotentOptions{
+		CreateTektonPipelineRunOptions: createTektonPipelineRunOptions,
+		IdempotencyKey:                 core.StringPtr(idempotencyKey),
+	}
+}
+
+// SetCreateTektonPipelineRunOptions : Allow user to set CreateTektonPipelineRunOptions
+func (_options *CreateTektonPipelineRunIdempotentOptions) SetCreateTektonPipelineRunOptions(createTektonPipelineRunOptions *CreateTektonPipelineRunOptions) *CreateTektonPipelineRunIdempotentOptions {
+	_options.CreateTektonPipelineRunOptions = createTektonPipelineRunOptions
+	return _options
+}
+
+// SetIdempotencyKey : Allow user to set IdempotencyKey
+func (_options *CreateTektonPipelineRunIdempotentOptions) SetIdempotencyKey(idempotencyKey string) *CreateTektonPipelineRunIdempotentOptions {
+	_options.IdempotencyKey = core.StringPtr(idempotencyKey)
+	return _options
+}
+
+// SetSearchLimit : Allow user to set SearchLimit
+func (_options *CreateTektonPipelineRunIdempotentOptions) SetSearchLimit(searchLimit int) *CreateTektonPipelineRunIdempotentOptions {
+	_options.SearchLimit = searchLimit
+	return _options
+}
+
+// IdempotentPipelineRun : The run returned by CreateTektonPipelineRunIdempotent.
+type IdempotentPipelineRun struct {
+	// The oldest run carrying the idempotency key.
+	Run *PipelineRun
+
+	// True when the run was created by this call.
+	Created bool
+
+	// The other runs carrying the key, created by retried requests, which were cancelled.
+	Duplicates []PipelineRunDuplicate
+}
+
+// PipelineRunDuplicate : A duplicate run cancelled by CreateTektonPipelineRunIdempotent.
+type PipelineRunDuplicate struct {
+	// The ID of the run.
+	ID string
+
+	// The error of the cancel request, nil when the run was cancelled.
+	CancelError error
+}
+
+// CreateTektonPipelineRunIdempotent : Trigger a pipeline run at most once per idempotency key
+// This embeds the idempotency key in the description of the run. If one of the recent runs of the pipeline already
+// carries the key, that run is returned and no run is created.
+//
+// Since the creation request may be retried by the SDK (see EnableRetries) or by the caller after a timeout, the runs
+// carrying the key are searched again after the creation. If several runs were created, the oldest one is returned
+// and the others are cancelled; the cancels that fail are reported in Duplicates rather than as an error.
+func (cdTektonPipeline *CdTektonPipelineV2) CreateTektonPipelineRunIdempotent(createTektonPipelineRunIdempotentOptions *CreateTektonPipelineRunIdempotentOptions) (result *IdempotentPipelineRun, err error) {
+	result, err = cdTektonPipeline.CreateTektonPipelineRunIdempotentWithContext(context.Background(), createTektonPipelineRunIdempotentOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateTektonPipelineRunIdempotentWithContext is an alternate form of the CreateTektonPipelineRunIdempotent method which supports a Context parameter.
+func (cdTektonPipeline *CdTektonPipelineV2) CreateTektonPipelineRunIdempotentWithContext(ctx context.Context, createTektonPipelineRunIdempotentOptions *CreateTektonPipelineRunIdempotentOptions) (result *IdempotentPipelineRun, err error) {
+	err = core.ValidateNotNil(createTektonPipelineRunIdempotentOptions, "createTektonPipelineRunIdempotentOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(createTektonPipelineRunIdempotentOptions, "createTektonPipelineRunIdempotentOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	key := *createTektonPipelineRunIdempotentOptions.IdempotencyKey
+	if !idempotencyKeyPattern.MatchString(key) {
+		err = core.SDKErrorf(nil, fmt.Sprintf("invalid idempotency key '%s'", key), "invalid-idempotency-key", common.GetComponentInfo())
+		return
+	}
+
+	existing, err := cdTektonPipeline.findIdempotentRuns(ctx, createTektonPipelineRunIdempotentOptions)
+	if err != nil {
+		return
+	}
+	if len(existing) > 0 {
+		result = &IdempotentPipelineRun{Run: &existing[len(existing)-1]}
+		return
+	}
+
+	createOptions := *createTektonPipelineRunIdempotentOptions.CreateTektonPipelineRunOptions
+	description := strings.TrimSpace(core.StringNilMapper(createOptions.Description) + " " + idempotencyKeyMarker(key))
+	createOptions.Description = core.StringPtr(description)
+	run, _, createErr := cdTektonPipeline.CreateTektonPipelineRunWithContext(ctx, &createOptions)
+
+	// Look for the run again, whether the creation succeeded or not: a failed request may have created it, and retried
+	// requests may have created it several times.
+	matches, findErr := cdTektonPipeline.findIdempotentRuns(ctx, createTektonPipelineRunIdempotentOptions)
+	if findErr != nil || len(matches) == 0 {
+		if createErr != nil {
+			err = core.RepurposeSDKProblem(createErr, "idempotent-create-error")
+			return
+		}
+		result = &IdempotentPipelineRun{Run: run, Created: true}
+		return
+	}
+
+	// The run of a failed request, or of a request retried by the SDK, may not be the one this call received.
+	result = &IdempotentPipelineRun{Run: &matches[len(matches)-1]}
+	result.Created = run != nil && run.ID != nil && core.StringNilMapper(result.Run.ID) == *run.ID
+	for index := range matches[:len(matches)-1] {
+		duplicate := PipelineRunDuplicate{ID: core.StringNilMapper(matches[index].ID)}
+		_, _, cancelErr := cdTektonPipeline.CancelTektonPipelineRunWithContext(ctx, &CancelTektonPipelineRunOptions{
+			PipelineID: createOptions.PipelineID,
+			ID:         matches[index].ID,
+			Headers:    createOptions.Headers,
+		})
+		if cancelErr != nil {
+			duplicate.CancelError = core.RepurposeSDKProblem(cancelErr, "idempotent-cancel-duplicate-error")
+		}
+		result.Duplicates = append(result.Duplicates, duplicate)
+	}
+	return
+}
+
+// findIdempotentRuns returns the recent runs carrying the idempotency key, from newest to oldest.
+func (cdTektonPipeline *CdTektonPipelineV2) findIdempotentRuns(ctx context.Context, options *CreateTektonPipelineRunIdempotentOptions) (matches []PipelineRun, err error) {
+	createOptions := options.CreateTektonPipelineRunOptions
+	searchLimit := options.SearchLimit
+	if searchLimit <= 0 {
+		searchLimit = DefaultIdempotencySearchLimit
+	}
+
+	listOptions := &ListTektonPipelineRunsOptions{
+		PipelineID:  createOptions.PipelineID,
+		TriggerName: createOptions.TriggerName,
+		Headers:     createOptions.Headers,
+	}
+	if listOptions.TriggerName == nil && createOptions.Trigger != nil {
+		listOptions.TriggerName = createOptions.Trigger.Name
+	}
+	pager, err := cdTektonPipeline.NewTektonPipelineRunsPager(listOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "idempotent-pager-error")
+		return
+	}
+
+	marker := idempotencyKeyMarker(*options.IdempotencyKey)
+	var searched []PipelineRun
+	for pager.HasNext() && len(searched) < searchLimit {
+		var page []PipelineRun
+		page, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "idempotent-list-runs-error")
+			return
+		}
+		searched = append(searched, page...)
+	}
+	for _, run := range searched[:min(len(searched), searchLimit)] {
+		if strings.Contains(core.StringNilMapper(run.Description), marker) {
+			matches = append(matches, run)
+		}
+	}
+	sortPipelineRunsNewestFirst(matches)
+	return
+}
@@ -0,0 +1,140 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CdTektonPipelineV2 CreateTektonPipelineRunIdempotent`, func() {
+	var store *mockRunStore
+	var testServer *httptest.Server
+	var cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+
+	BeforeEach(func() {
+		store = newMockRunStore()
+		store.add("PipelineID", mockRun{ID: "old", Status: "succeeded", Trigger: "manual", Description: "nightly [idempotency-key:other]"})
+		testServer = store.server()
+		var serviceErr error
+		cdTektonPipelineService, serviceErr = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newOptions := func(key string) *cdtektonpipelinev2.CreateTektonPipelineRunIdempotentOptions {
+		createOptions := cdTektonPipelineService.NewCreateTektonPipelineRunOptions("PipelineID").
+			SetTriggerName("manual").
+			SetDescription("release")
+		return cdTektonPipelineService.NewCreateTektonPipelineRunIdempotentOptions(createOptions, key)
+	}
+
+	// failCreates makes the next create requests store the run but fail, as if the response was lost.
+	failCreates := func(count int) {
+		store.intercept(func(res http.ResponseWriter, req *http.Request) bool {
+			if count == 0 || req.Method != "POST" || req.URL.Path != "/tekton_pipelines/PipelineID/pipeline_runs" {
+				return false
+			}
+			count--
+			store.createRequested("PipelineID", req)
+			writeMockError(res, 503, "unavailable")
+			return true
+		})
+	}
+
+	It(`Invoke CreateTektonPipelineRunIdempotent creates the run once`, func() {
+		options := newOptions("release-1.2.3")
+		result, err := cdTektonPipelineService.CreateTektonPipelineRunIdempotent(options)
+		Expect(err).To(BeNil())
+		Expect(result.Created).To(BeTrue())
+		Expect(result.Duplicates).To(BeEmpty())
+		run := result.Run
+		Expect(*run.ID).To(Equal("new-1"))
+		Expect(*run.Description).To(Equal("release [idempotency-key:release-1.2.3]"))
+		Expect(cdtektonpipelinev2.PipelineRunIdempotencyKey(run)).To(Equal("release-1.2.3"))
+		Expect(*options.CreateTektonPipelineRunOptions.Description).To(Equal("release"))
+
+		result, err = cdTektonPipelineService.CreateTektonPipelineRunIdempotent(options)
+		Expect(err).To(BeNil())
+		Expect(result.Created).To(BeFalse())
+		Expect(*result.Run.ID).To(Equal("new-1"))
+		Expect(store.requestLog()).To(Equal([]string{
+			"GET /tekton_pipelines/PipelineID/pipeline_runs",
+			"POST /tekton_pipelines/PipelineID/pipeline_runs",
+			"GET /tekton_pipelines/PipelineID/pipeline_runs",
+			"GET /tekton_pipelines/PipelineID/pipeline_runs",
+		}))
+	})
+	It(`Invoke CreateTektonPipelineRunIdempotent cancels the duplicates of a retried request`, func() {
+		failCreates(1)
+		cdTektonPipelineService.EnableRetries(2, 10*time.Millisecond)
+
+		result, err := cdTektonPipelineService.CreateTektonPipelineRunIdempotent(newOptions("deploy-42"))
+		Expect(err).To(BeNil())
+		Expect(result.Created).To(BeFalse())
+		Expect(*result.Run.ID).To(Equal("new-1"))
+		Expect(result.Duplicates).To(Equal([]cdtektonpipelinev2.PipelineRunDuplicate{{ID: "new-2"}}))
+		Expect(store.requestLog()).To(ContainElement("POST /tekton_pipelines/PipelineID/pipeline_runs/new-2/cancel"))
+		Expect(store.requestLog()).ToNot(ContainElement("POST /tekton_pipelines/PipelineID/pipeline_runs/new-1/cancel"))
+	})
+	It(`Invoke CreateTektonPipelineRunIdempotent returns a run created by a failed request`, func() {
+		failCreates(1)
+
+		result, err := cdTektonPipelineService.CreateTektonPipelineRunIdempotent(newOptions("deploy-43"))
+		Expect(err).To(BeNil())
+		Expect(result.Created).To(BeFalse())
+		Expect(*result.Run.ID).To(Equal("new-1"))
+	})
+	It(`Invoke CreateTektonPipelineRunIdempotent reports the duplicates it fails to cancel`, func() {
+		store.failing = map[string]bool{"new-2": true}
+		failCreates(1)
+		cdTektonPipelineService.EnableRetries(2, 10*time.Millisecond)
+
+		result, err := cdTektonPipelineService.CreateTektonPipelineRunIdempotent(newOptions("deploy-44"))
+		Expect(err).To(BeNil())
+		Expect(*result.Run.ID).To(Equal("new-1"))
+		Expect(result.Duplicates).To(HaveLen(1))
+		Expect(result.Duplicates[0].ID).To(Equal("new-2"))
+		Expect(result.Duplicates[0].CancelError).To(MatchError(ContainSubstring("request failed")))
+	})
+	It(`Invoke CreateTektonPipelineRunIdempotent with error: Operation validation and request error`, func() {
+		_, err := cdTektonPipelineService.CreateTektonPipelineRunIdempotent(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.CreateTektonPipelineRunIdempotent(newOptions(""))
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.CreateTektonPipelineRunIdempotent(newOptions("has space"))
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.CreateTektonPipelineRunIdempotent(
+			cdTektonPipelineService.NewCreateTektonPipelineRunIdempotentOptions(nil, "key"))
+		Expect(err).ToNot(BeNil())
+
+		testServer.Close()
+		_, err = cdTektonPipelineService.CreateTektonPipelineRunIdempotent(newOptions("deploy-45"))
+		Expect(err).ToNot(BeNil())
+	})
+})
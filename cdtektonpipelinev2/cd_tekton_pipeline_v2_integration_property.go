@@ -0,0 +1,243 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// toolParametersSegment is the optional first segment of integration property paths, which select a value from the
+// parameters of the tool.
+const toolParametersSegment = "parameters"
+
+// IntegrationPropertyResolverOptions : The NewIntegrationPropertyResolver options.
+type IntegrationPropertyResolverOptions struct {
+	// The toolchain service used to get the tools.
+	ToolchainService *cdtoolchainv2.CdToolchainV2 `json:"-" validate:"required"`
+
+	// The ID of the toolchain of the pipeline.
+	ToolchainID *string `json:"toolchain_id" validate:"required,ne="`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewIntegrationPropertyResolverOptions : Instantiate IntegrationPropertyResolverOptions
+func (*CdTektonPipelineV2) NewIntegrationPropertyResolverOptions(toolchainService *cdtoolchainv2.CdToolchainV2, toolchainID string) *IntegrationPropertyResolverOptions {
+	return &IntegrationPropertyResolverOptions{
+		ToolchainService: toolchainService,
+		ToolchainID:      core.StringPtr(toolchainID),
+	}
+}
+
+// SetToolchainService : Allow user to set ToolchainService
+func (_options *IntegrationPropertyResolverOptions) SetToolchainService(toolchainService *cdtoolchainv2.CdToolchainV2) *IntegrationPropertyResolverOptions {
+	_options.ToolchainService = toolchainService
+	return _options
+}
+
+// SetToolchainID : Allow user to set ToolchainID
+func (_options *IntegrationPropertyResolverOptions) SetToolchainID(toolchainID string) *IntegrationPropertyResolverOptions {
+	_options.ToolchainID = core.StringPtr(toolchainID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *IntegrationPropertyResolverOptions) SetHeaders(param map[string]string) *IntegrationPropertyResolverOptions {
+	options.Headers = param
+	return options
+}
+
+// IntegrationPropertyResolver : Resolves the properties of type `integration`, whose value is the ID of a tool of the
+// toolchain and whose path selects a value from the parameters of the tool. Each tool is read once with
+// GetToolByID; a resolver is safe for concurrent use.
+type IntegrationPropertyResolver struct {
+	options IntegrationPropertyResolverOptions
+
+	mutex sync.Mutex
+	tools map[string]*cdtoolchainv2.ToolchainTool
+}
+
+// NewIntegrationPropertyResolver returns a resolver for the integration properties of the pipelines of a toolchain.
+func (*CdTektonPipelineV2) NewIntegrationPropertyResolver(integrationPropertyResolverOptions *IntegrationPropertyResolverOptions) (resolver *IntegrationPropertyResolver, err error) {
+	err = core.ValidateNotNil(integrationPropertyResolverOptions, "integrationPropertyResolverOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(integrationPropertyResolverOptions, "integrationPropertyResolverOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	resolver = &IntegrationPropertyResolver{
+		options: *integrationPropertyResolverOptions,
+		tools:   make(map[string]*cdtoolchainv2.ToolchainTool),
+	}
+	return
+}
+
+// Resolve returns the value that the path selects from the parameters of the tool with the specified ID.
+func (resolver *IntegrationPropertyResolver) Resolve(toolID string, path string) (value interface{}, err error) {
+	value, err = resolver.ResolveWithContext(context.Background(), toolID, path)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ResolveWithContext is an alternate form of the Resolve method which supports a Context parameter.
+func (resolver *IntegrationPropertyResolver) ResolveWithContext(ctx context.Context, toolID string, path string) (value interface{}, err error) {
+	tool, err := resolver.getTool(ctx, toolID)
+	if err != nil {
+		return
+	}
+	value, err = evaluateToolParameterPath(tool.Parameters, path)
+	if err != nil {
+		err = core.SDKErrorf(nil, fmt.Sprintf("cannot resolve path '%s' in tool '%s': %s", path, toolID, err.Error()), "integration-path-not-found", common.GetComponentInfo())
+	}
+	return
+}
+
+// ResolveProperty returns the value of a property of a pipeline run: the value selected from the tool for integration
+// properties, and the value of the property otherwise.
+func (resolver *IntegrationPropertyResolver) ResolveProperty(property *PipelineRunProperty) (value interface{}, err error) {
+	value, err = resolver.ResolvePropertyWithContext(context.Background(), property)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ResolvePropertyWithContext is an alternate form of the ResolveProperty method which supports a Context parameter.
+func (resolver *IntegrationPropertyResolver) ResolvePropertyWithContext(ctx context.Context, property *PipelineRunProperty) (value interface{}, err error) {
+	err = core.ValidateNotNil(property, "property cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if property.Type != PropertyTypeIntegrationConst {
+		return property.Value, nil
+	}
+	return resolver.ResolveWithContext(ctx, property.Value, property.Path)
+}
+
+// getTool returns the tool with the specified ID, reading it on first use.
+func (resolver *IntegrationPropertyResolver) getTool(ctx context.Context, toolID string) (tool *cdtoolchainv2.ToolchainTool, err error) {
+	resolver.mutex.Lock()
+	tool = resolver.tools[toolID]
+	resolver.mutex.Unlock()
+	if tool != nil {
+		return
+	}
+
+	tool, _, err = resolver.options.ToolchainService.GetToolByIDWithContext(ctx, &cdtoolchainv2.GetToolByIDOptions{
+		ToolchainID: resolver.options.ToolchainID,
+		ToolID:      core.StringPtr(toolID),
+		Headers:     resolver.options.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "integration-get-tool-error")
+		return
+	}
+	resolver.mutex.Lock()
+	resolver.tools[toolID] = tool
+	resolver.mutex.Unlock()
+	return
+}
+
+// EvaluateToolParameterPath returns the value that a dot-notation path selects from the parameters of a tool. Array
+// elements are selected with numeric segments or indexes, such as `repos.0.url` or `repos[0].url`. The path can start
+// with a `parameters` segment, and an empty path selects all the parameters.
+func EvaluateToolParameterPath(parameters map[string]interface{}, path string) (value interface{}, err error) {
+	value, err = evaluateToolParameterPath(parameters, path)
+	if err != nil {
+		err = core.SDKErrorf(nil, fmt.Sprintf("cannot resolve path '%s': %s", path, err.Error()), "integration-path-not-found", common.GetComponentInfo())
+	}
+	return
+}
+
+func evaluateToolParameterPath(parameters map[string]interface{}, path string) (interface{}, error) {
+	if path == "" {
+		return maps.Clone(parameters), nil
+	}
+	segments, err := splitToolParameterPath(path)
+	if err != nil {
+		return nil, err
+	}
+	if _, found := parameters[toolParametersSegment]; !found && segments[0] == toolParametersSegment {
+		segments = segments[1:]
+	}
+
+	var value interface{} = parameters
+	for index, segment := range segments {
+		parent := "the parameters"
+		if index > 0 {
+			parent = fmt.Sprintf("'%s'", strings.Join(segments[:index], "."))
+		}
+		switch container := value.(type) {
+		case map[string]interface{}:
+			child, found := container[segment]
+			if !found {
+				return nil, fmt.Errorf("segment '%s' not found in %s", segment, parent)
+			}
+			value = child
+		case []interface{}:
+			position, convErr := strconv.Atoi(segment)
+			if convErr != nil || position < 0 {
+				return nil, fmt.Errorf("segment '%s' is not an index of the array %s", segment, parent)
+			}
+			if position >= len(container) {
+				return nil, fmt.Errorf("segment '%s' not found in %s, which has %d elements", segment, parent, len(container))
+			}
+			value = container[position]
+		default:
+			return nil, fmt.Errorf("segment '%s' not found in %s, which is a %T", segment, parent, value)
+		}
+	}
+	return value, nil
+}
+
+// splitToolParameterPath splits a path into segments, with the indexes in brackets as segments of their own.
+func splitToolParameterPath(path string) (segments []string, err error) {
+	for _, part := range strings.Split(path, ".") {
+		name, indexes, _ := strings.Cut(part, "[")
+		if indexes != "" {
+			indexes = "[" + indexes
+		}
+		if name != "" {
+			segments = append(segments, name)
+		} else if indexes == "" || len(segments) == 0 {
+			return nil, fmt.Errorf("empty segment")
+		}
+		for indexes != "" {
+			end := strings.Index(indexes, "]")
+			if !strings.HasPrefix(indexes, "[") || end < 0 {
+				return nil, fmt.Errorf("invalid index in segment '%s'", part)
+			}
+			segments = append(segments, indexes[1:end])
+			indexes = indexes[end+1:]
+		}
+	}
+	return
+}
@@ -0,0 +1,141 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtoolchainv2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CdTektonPipelineV2 IntegrationPropertyResolver`, func() {
+	var requests atomic.Int32
+	var testServer *httptest.Server
+	var cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+	var cdToolchainService *cdtoolchainv2.CdToolchainV2
+
+	BeforeEach(func() {
+		requests.Store(0)
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			requests.Add(1)
+			if req.URL.Path != "/toolchains/ToolchainID/tools/tool-1" {
+				res.WriteHeader(404)
+				return
+			}
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprint(res, `{"id": "tool-1", "tool_type_id": "githubconsolidated", "toolchain_id": "ToolchainID", "state": "configured",
+				"parameters": {
+					"repo_url": "https://github.com/org/app",
+					"enable_traceability": true,
+					"repos": [{"url": "https://github.com/org/a", "ports": [80, 443]}, {"url": "https://github.com/org/b"}],
+					"owner": {"login": "org", "id": 7}
+				}}`)
+		}))
+		var serviceErr error
+		cdTektonPipelineService, serviceErr = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		cdToolchainService, serviceErr = cdtoolchainv2.NewCdToolchainV2(&cdtoolchainv2.CdToolchainV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Invoke Resolve successfully`, func() {
+		resolver, err := cdTektonPipelineService.NewIntegrationPropertyResolver(
+			cdTektonPipelineService.NewIntegrationPropertyResolverOptions(cdToolchainService, "ToolchainID"))
+		Expect(err).To(BeNil())
+
+		for path, expected := range map[string]interface{}{
+			"repo_url":            "https://github.com/org/app",
+			"parameters.repo_url": "https://github.com/org/app",
+			"enable_traceability": true,
+			"repos[1].url":        "https://github.com/org/b",
+			"repos.0.ports[1]":    float64(443),
+			"owner.login":         "org",
+			"owner":               map[string]interface{}{"login": "org", "id": float64(7)},
+		} {
+			value, err := resolver.Resolve("tool-1", path)
+			Expect(err).To(BeNil(), path)
+			Expect(value).To(Equal(expected), path)
+		}
+		value, err := resolver.Resolve("tool-1", "")
+		Expect(err).To(BeNil())
+		Expect(value).To(HaveKey("repos"))
+		Expect(requests.Load()).To(Equal(int32(1)))
+
+		value, err = resolver.ResolveProperty(&cdtektonpipelinev2.PipelineRunProperty{Name: "repo", Type: "integration", Value: "tool-1", Path: "repos[0].url"})
+		Expect(err).To(BeNil())
+		Expect(value).To(Equal("https://github.com/org/a"))
+		value, err = resolver.ResolveProperty(&cdtektonpipelinev2.PipelineRunProperty{Name: "region", Type: "text", Value: "us-south"})
+		Expect(err).To(BeNil())
+		Expect(value).To(Equal("us-south"))
+		Expect(requests.Load()).To(Equal(int32(1)))
+	})
+	It(`Invoke Resolve with error: Missing segments`, func() {
+		resolver, err := cdTektonPipelineService.NewIntegrationPropertyResolver(
+			cdTektonPipelineService.NewIntegrationPropertyResolverOptions(cdToolchainService, "ToolchainID"))
+		Expect(err).To(BeNil())
+
+		for path, message := range map[string]string{
+			"branch":            "cannot resolve path 'branch' in tool 'tool-1': segment 'branch' not found in the parameters",
+			"owner.name":        "segment 'name' not found in 'owner'",
+			"repos[2].url":      "segment '2' not found in 'repos', which has 2 elements",
+			"repos.first":       "segment 'first' is not an index of the array 'repos'",
+			"repo_url.host":     "segment 'host' not found in 'repo_url', which is a string",
+			"repos[0]ports":     "invalid index in segment 'repos[0]ports'",
+			"owner..login":      "empty segment",
+			"repos[0].ports[9]": "segment '9' not found in 'repos.0.ports', which has 2 elements",
+		} {
+			_, err = resolver.Resolve("tool-1", path)
+			Expect(err).ToNot(BeNil(), path)
+			Expect(err.Error()).To(ContainSubstring(message), path)
+		}
+
+		_, err = resolver.Resolve("missing", "repo_url")
+		Expect(err).ToNot(BeNil())
+		_, err = resolver.ResolveProperty(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.NewIntegrationPropertyResolver(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.NewIntegrationPropertyResolver(cdTektonPipelineService.NewIntegrationPropertyResolverOptions(nil, "ToolchainID"))
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Invoke EvaluateToolParameterPath successfully`, func() {
+		value, err := cdtektonpipelinev2.EvaluateToolParameterPath(map[string]interface{}{"parameters": map[string]interface{}{"a": "b"}}, "parameters.a")
+		Expect(err).To(BeNil())
+		Expect(value).To(Equal("b"))
+		_, err = cdtektonpipelinev2.EvaluateToolParameterPath(map[string]interface{}{}, "a")
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(Equal("cannot resolve path 'a': segment 'a' not found in the parameters"))
+	})
+})
@@ -0,0 +1,418 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+)
+
+// DefaultLogArchiveConcurrency is the number of step logs fetched in parallel by ExportTektonPipelineRunLogs when
+// no concurrency is configured.
+const DefaultLogArchiveConcurrency = 4
+
+// PipelineRunLogArchiveManifestName is the name of the manifest file written at the root of every log archive.
+const PipelineRunLogArchiveManifestName = "manifest.json"
+
+// ExportTektonPipelineRunLogsOptions : The ExportTektonPipelineRunLogs options.
+type ExportTektonPipelineRunLogsOptions struct {
+	// The Tekton pipeline ID.
+	PipelineID *string `json:"pipeline_id" validate:"required,ne="`
+
+	// The Tekton pipeline run ID.
+	ID *string `json:"id" validate:"required,ne="`
+
+	// The destination of the archive.
+	Writer io.Writer `json:"-" validate:"required"`
+
+	// The archive format. Defaults to tar.gz.
+	Format *string `json:"format,omitempty"`
+
+	// Maximum number of step logs fetched in parallel. Defaults to DefaultLogArchiveConcurrency.
+	Concurrency int
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// Constants associated with the ExportTektonPipelineRunLogsOptions.Format property.
+// The archive format.
+const (
+	ExportTektonPipelineRunLogsOptionsFormatTarGzConst = "tar.gz"
+	ExportTektonPipelineRunLogsOptionsFormatZipConst   = "zip"
+)
+
+// NewExportTektonPipelineRunLogsOptions : Instantiate ExportTektonPipelineRunLogsOptions
+func (*CdTektonPipelineV2) NewExportTektonPipelineRunLogsOptions(pipelineID string, id string, writer io.Writer) *ExportTektonPipelineRunLogsOptions {
+	return &ExportTektonPipelineRunLogsOptions{
+		PipelineID: core.StringPtr(pipelineID),
+		ID:         core.StringPtr(id),
+		Writer:     writer,
+	}
+}
+
+// SetPipelineID : Allow user to set PipelineID
+func (_options *ExportTektonPipelineRunLogsOptions) SetPipelineID(pipelineID string) *ExportTektonPipelineRunLogsOptions {
+	_options.PipelineID = core.StringPtr(pipelineID)
+	return _options
+}
+
+// SetID : Allow user to set ID
+func (_options *ExportTektonPipelineRunLogsOptions) SetID(id string) *ExportTektonPipelineRunLogsOptions {
+	_options.ID = core.StringPtr(id)
+	return _options
+}
+
+// SetWriter : Allow user to set Writer
+func (_options *ExportTektonPipelineRunLogsOptions) SetWriter(writer io.Writer) *ExportTektonPipelineRunLogsOptions {
+	_options.Writer = writer
+	return _options
+}
+
+// SetFormat : Allow user to set Format
+func (_options *ExportTektonPipelineRunLogsOptions) SetFormat(format string) *ExportTektonPipelineRunLogsOptions {
+	_options.Format = core.StringPtr(format)
+	return _options
+}
+
+// SetConcurrency : Allow user to set Concurrency
+func (_options *ExportTektonPipelineRunLogsOptions) SetConcurrency(concurrency int) *ExportTektonPipelineRunLogsOptions {
+	_options.Concurrency = concurrency
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ExportTektonPipelineRunLogsOptions) SetHeaders(param map[string]string) *ExportTektonPipelineRunLogsOptions {
+	options.Headers = param
+	return options
+}
+
+// PipelineRunLogArchiveManifest : Description of a pipeline run log archive, stored as manifest.json in the archive.
+type PipelineRunLogArchiveManifest struct {
+	// The pipeline run ID.
+	ID *string `json:"id"`
+
+	// The ID of the pipeline to which the pipeline run belongs.
+	PipelineID *string `json:"pipeline_id"`
+
+	// Status of the pipeline run when the archive was written.
+	Status *string `json:"status"`
+
+	// The aggregated definition ID.
+	DefinitionID *string `json:"definition_id"`
+
+	// The trigger that started the pipeline run.
+	Trigger TriggerIntf `json:"trigger,omitempty"`
+
+	// Standard RFC 3339 Date Time String.
+	CreatedAt *strfmt.DateTime `json:"created_at"`
+
+	// Standard RFC 3339 Date Time String.
+	UpdatedAt *strfmt.DateTime `json:"updated_at,omitempty"`
+
+	// Properties used in the pipeline run.
+	Properties []Property `json:"properties,omitempty"`
+
+	// The step logs stored in the archive, in the order listed by the service.
+	Logs []PipelineRunLogArchiveEntry `json:"logs"`
+
+	// The names of the step logs listed without an ID, whose content could not be fetched.
+	SkippedLogs []string `json:"skipped_logs,omitempty"`
+}
+
+// PipelineRunLogArchiveEntry : A step log stored in a pipeline run log archive.
+type PipelineRunLogArchiveEntry struct {
+	// Step log ID.
+	ID string `json:"id"`
+
+	// <podName>/<containerName> of this log.
+	Name string `json:"name"`
+
+	// Path of the log file inside the archive.
+	Path string `json:"path"`
+
+	// Size of the log file in bytes.
+	Size int `json:"size"`
+}
+
+// ExportTektonPipelineRunLogs : Export all logs of a pipeline run as an archive
+// This fetches every step log of the pipeline run identified by `{id}` and writes them to a tar.gz or zip archive laid
+// out as `<podName>/<containerName>.log`, together with a manifest.json describing the run.
+func (cdTektonPipeline *CdTektonPipelineV2) ExportTektonPipelineRunLogs(exportTektonPipelineRunLogsOptions *ExportTektonPipelineRunLogsOptions) (result *PipelineRunLogArchiveManifest, err error) {
+	result, err = cdTektonPipeline.ExportTektonPipelineRunLogsWithContext(context.Background(), exportTektonPipelineRunLogsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ExportTektonPipelineRunLogsWithContext is an alternate form of the ExportTektonPipelineRunLogs method which supports a Context parameter
+func (cdTektonPipeline *CdTektonPipelineV2) ExportTektonPipelineRunLogsWithContext(ctx context.Context, exportTektonPipelineRunLogsOptions *ExportTektonPipelineRunLogsOptions) (result *PipelineRunLogArchiveManifest, err error) {
+	err = core.ValidateNotNil(exportTektonPipelineRunLogsOptions, "exportTektonPipelineRunLogsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(exportTektonPipelineRunLogsOptions, "exportTektonPipelineRunLogsOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	format := ExportTektonPipelineRunLogsOptionsFormatTarGzConst
+	if exportTektonPipelineRunLogsOptions.Format != nil {
+		format = *exportTektonPipelineRunLogsOptions.Format
+	}
+	if format != ExportTektonPipelineRunLogsOptionsFormatTarGzConst && format != ExportTektonPipelineRunLogsOptionsFormatZipConst {
+		err = core.SDKErrorf(nil, fmt.Sprintf("unsupported archive format '%s'", format), "invalid-archive-format", common.GetComponentInfo())
+		return
+	}
+
+	run, _, err := cdTektonPipeline.GetTektonPipelineRunWithContext(ctx, &GetTektonPipelineRunOptions{
+		PipelineID: exportTektonPipelineRunLogsOptions.PipelineID,
+		ID:         exportTektonPipelineRunLogsOptions.ID,
+		Headers:    exportTektonPipelineRunLogsOptions.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "export-get-run-error")
+		return
+	}
+
+	logs, _, err := cdTektonPipeline.GetTektonPipelineRunLogsWithContext(ctx, &GetTektonPipelineRunLogsOptions{
+		PipelineID: exportTektonPipelineRunLogsOptions.PipelineID,
+		ID:         exportTektonPipelineRunLogsOptions.ID,
+		Headers:    exportTektonPipelineRunLogsOptions.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "export-get-logs-error")
+		return
+	}
+
+	var stepLogs []Log
+	var skippedLogs []string
+	for _, log := range logs.Logs {
+		if log.ID == nil {
+			skippedLogs = append(skippedLogs, core.StringNilMapper(log.Name))
+			continue
+		}
+		stepLogs = append(stepLogs, log)
+	}
+	contents, err := cdTektonPipeline.fetchStepLogs(ctx, exportTektonPipelineRunLogsOptions, stepLogs)
+	if err != nil {
+		return
+	}
+
+	result = &PipelineRunLogArchiveManifest{
+		ID:           run.ID,
+		PipelineID:   run.PipelineID,
+		Status:       run.Status,
+		DefinitionID: run.DefinitionID,
+		Trigger:      run.Trigger,
+		CreatedAt:    run.CreatedAt,
+		UpdatedAt:    run.UpdatedAt,
+		Properties:   run.Properties,
+		Logs:         []PipelineRunLogArchiveEntry{},
+		SkippedLogs:  skippedLogs,
+	}
+	usedPaths := make(map[string]bool)
+	for index, log := range stepLogs {
+		entryPath := logArchivePath(core.StringNilMapper(log.Name), core.StringNilMapper(log.ID), usedPaths)
+		result.Logs = append(result.Logs, PipelineRunLogArchiveEntry{
+			ID:   core.StringNilMapper(log.ID),
+			Name: core.StringNilMapper(log.Name),
+			Path: entryPath,
+			Size: len(contents[index]),
+		})
+	}
+
+	manifest, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "manifest-marshal-error", common.GetComponentInfo())
+		return
+	}
+
+	modTime := time.Now()
+	if run.UpdatedAt != nil {
+		modTime = time.Time(*run.UpdatedAt)
+	} else if run.CreatedAt != nil {
+		modTime = time.Time(*run.CreatedAt)
+	}
+
+	var archive logArchiveWriter
+	if format == ExportTektonPipelineRunLogsOptionsFormatZipConst {
+		archive = newZipLogArchiveWriter(exportTektonPipelineRunLogsOptions.Writer)
+	} else {
+		archive = newTarGzLogArchiveWriter(exportTektonPipelineRunLogsOptions.Writer)
+	}
+	err = archive.add(PipelineRunLogArchiveManifestName, manifest, modTime)
+	for index := 0; err == nil && index < len(result.Logs); index++ {
+		err = archive.add(result.Logs[index].Path, []byte(contents[index]), modTime)
+	}
+	if err == nil {
+		err = archive.close()
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "archive-write-error", common.GetComponentInfo())
+		return
+	}
+	return
+}
+
+// fetchStepLogs fetches the content of the specified step logs with bounded concurrency, preserving their order.
+func (cdTektonPipeline *CdTektonPipelineV2) fetchStepLogs(ctx context.Context, options *ExportTektonPipelineRunLogsOptions, logs []Log) (contents []string, err error) {
+	concurrency := options.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultLogArchiveConcurrency
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	contents = make([]string, len(logs))
+	var errOnce sync.Once
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, concurrency)
+	for index := range logs {
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			select {
+			case semaphore <- struct{}{}:
+				defer func() { <-semaphore }()
+			case <-ctx.Done():
+				return
+			}
+
+			stepLog, _, getErr := cdTektonPipeline.GetTektonPipelineRunLogContentWithContext(ctx, &GetTektonPipelineRunLogContentOptions{
+				PipelineID:    options.PipelineID,
+				PipelineRunID: options.ID,
+				ID:            logs[index].ID,
+				Headers:       options.Headers,
+			})
+			if getErr != nil {
+				errOnce.Do(func() {
+					err = core.RepurposeSDKProblem(getErr, "export-get-log-content-error")
+					cancel()
+				})
+				return
+			}
+			contents[index] = core.StringNilMapper(stepLog.Data)
+		}(index)
+	}
+	wg.Wait()
+
+	if err == nil && ctx.Err() != nil {
+		err = core.SDKErrorf(ctx.Err(), "", "export-cancelled", common.GetComponentInfo())
+	}
+	return
+}
+
+// logArchivePath returns a unique, relative archive path for a step log named `<podName>/<containerName>`.
+func logArchivePath(name string, id string, usedPaths map[string]bool) string {
+	var segments []string
+	for _, segment := range strings.Split(name, "/") {
+		if segment != "" && segment != "." && segment != ".." {
+			segments = append(segments, segment)
+		}
+	}
+	if len(segments) == 0 {
+		segments = []string{id}
+	}
+	base := path.Join(segments...)
+
+	candidate := base + ".log"
+	for suffix := 2; usedPaths[candidate]; suffix++ {
+		candidate = fmt.Sprintf("%s-%d.log", base, suffix)
+	}
+	usedPaths[candidate] = true
+	return candidate
+}
+
+// logArchiveWriter abstracts the archive formats supported by ExportTektonPipelineRunLogs.
+type logArchiveWriter interface {
+	add(name string, data []byte, modTime time.Time) error
+	close() error
+}
+
+type tarGzLogArchiveWriter struct {
+	gzipWriter *gzip.Writer
+	tarWriter  *tar.Writer
+}
+
+func newTarGzLogArchiveWriter(writer io.Writer) *tarGzLogArchiveWriter {
+	gzipWriter := gzip.NewWriter(writer)
+	return &tarGzLogArchiveWriter{
+		gzipWriter: gzipWriter,
+		tarWriter:  tar.NewWriter(gzipWriter),
+	}
+}
+
+func (archive *tarGzLogArchiveWriter) add(name string, data []byte, modTime time.Time) error {
+	err := archive.tarWriter.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    int64(len(data)),
+		ModTime: modTime,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = archive.tarWriter.Write(data)
+	return err
+}
+
+func (archive *tarGzLogArchiveWriter) close() error {
+	if err := archive.tarWriter.Close(); err != nil {
+		return err
+	}
+	return archive.gzipWriter.Close()
+}
+
+type zipLogArchiveWriter struct {
+	zipWriter *zip.Writer
+}
+
+func newZipLogArchiveWriter(writer io.Writer) *zipLogArchiveWriter {
+	return &zipLogArchiveWriter{zipWriter: zip.NewWriter(writer)}
+}
+
+func (archive *zipLogArchiveWriter) add(name string, data []byte, modTime time.Time) error {
+	fileWriter, err := archive.zipWriter.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: modTime,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = fileWriter.Write(data)
+	return err
+}
+
+func (archive *zipLogArchiveWriter) close() error {
+	return archive.zipWriter.Close()
+}
@@ -0,0 +1,158 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CdTektonPipelineV2 ExportTektonPipelineRunLogs`, func() {
+	var testServer *httptest.Server
+	var cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+
+	expectedFiles := map[string]string{
+		"build-pod/step-compile.log":   "compiling\n",
+		"build-pod/step-compile-2.log": "compiling again\n",
+		"test-pod/step-unit.log":       "ok\n",
+	}
+
+	BeforeEach(func() {
+		testServer = newLogPollServer(logPoll{status: "failed", logs: [][2]string{
+			{"build-pod/step-compile", "compiling\n"},
+			{"build-pod/step-compile", "compiling again\n"},
+			{"test-pod/step-unit", "ok\n"},
+		}})
+		var serviceErr error
+		cdTektonPipelineService, serviceErr = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	expectManifest := func(data []byte) {
+		manifest := make(map[string]interface{})
+		Expect(json.Unmarshal(data, &manifest)).To(Succeed())
+		Expect(manifest["id"]).To(Equal("RunID"))
+		Expect(manifest["status"]).To(Equal("failed"))
+		Expect(manifest["definition_id"]).To(Equal("DefinitionID"))
+		Expect(manifest["trigger"]).To(HaveKeyWithValue("name", "start-deploy"))
+		Expect(manifest["logs"]).To(HaveLen(3))
+	}
+
+	It(`Invoke ExportTektonPipelineRunLogs as tar.gz`, func() {
+		var buffer bytes.Buffer
+		exportOptions := cdTektonPipelineService.NewExportTektonPipelineRunLogsOptions("PipelineID", "RunID", &buffer).
+			SetConcurrency(2)
+		result, err := cdTektonPipelineService.ExportTektonPipelineRunLogs(exportOptions)
+		Expect(err).To(BeNil())
+		Expect(*result.Status).To(Equal("failed"))
+		Expect(result.Logs[1].Path).To(Equal("build-pod/step-compile-2.log"))
+
+		gzipReader, err := gzip.NewReader(&buffer)
+		Expect(err).To(BeNil())
+		tarReader := tar.NewReader(gzipReader)
+		files := make(map[string]string)
+		for {
+			header, err := tarReader.Next()
+			if err == io.EOF {
+				break
+			}
+			Expect(err).To(BeNil())
+			data, err := io.ReadAll(tarReader)
+			Expect(err).To(BeNil())
+			if header.Name == cdtektonpipelinev2.PipelineRunLogArchiveManifestName {
+				expectManifest(data)
+				continue
+			}
+			files[header.Name] = string(data)
+		}
+		Expect(files).To(Equal(expectedFiles))
+	})
+	It(`Invoke ExportTektonPipelineRunLogs as zip`, func() {
+		var buffer bytes.Buffer
+		exportOptions := cdTektonPipelineService.NewExportTektonPipelineRunLogsOptions("PipelineID", "RunID", &buffer).
+			SetFormat(cdtektonpipelinev2.ExportTektonPipelineRunLogsOptionsFormatZipConst)
+		_, err := cdTektonPipelineService.ExportTektonPipelineRunLogs(exportOptions)
+		Expect(err).To(BeNil())
+
+		zipReader, err := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+		Expect(err).To(BeNil())
+		files := make(map[string]string)
+		for _, file := range zipReader.File {
+			fileReader, err := file.Open()
+			Expect(err).To(BeNil())
+			data, err := io.ReadAll(fileReader)
+			Expect(err).To(BeNil())
+			if file.Name == cdtektonpipelinev2.PipelineRunLogArchiveManifestName {
+				expectManifest(data)
+				continue
+			}
+			files[file.Name] = string(data)
+		}
+		Expect(files).To(Equal(expectedFiles))
+	})
+	It(`Invoke ExportTektonPipelineRunLogs skips the logs without ID`, func() {
+		logServer := newLogPollServer(logPoll{status: "failed", logs: [][2]string{
+			{"build-pod/step-compile", "compiling\n"},
+			{"build-pod/step-pending", ""},
+		}, withoutID: map[string]bool{"build-pod/step-pending": true}})
+		defer logServer.Close()
+		Expect(cdTektonPipelineService.SetServiceURL(logServer.URL)).To(Succeed())
+
+		var buffer bytes.Buffer
+		result, err := cdTektonPipelineService.ExportTektonPipelineRunLogs(
+			cdTektonPipelineService.NewExportTektonPipelineRunLogsOptions("PipelineID", "RunID", &buffer))
+		Expect(err).To(BeNil())
+		Expect(result.Logs).To(Equal([]cdtektonpipelinev2.PipelineRunLogArchiveEntry{
+			{ID: "log-0", Name: "build-pod/step-compile", Path: "build-pod/step-compile.log", Size: 10},
+		}))
+		Expect(result.SkippedLogs).To(Equal([]string{"build-pod/step-pending"}))
+	})
+	It(`Invoke ExportTektonPipelineRunLogs with error: Operation validation and request error`, func() {
+		_, err := cdTektonPipelineService.ExportTektonPipelineRunLogs(nil)
+		Expect(err).ToNot(BeNil())
+
+		_, err = cdTektonPipelineService.ExportTektonPipelineRunLogs(
+			cdTektonPipelineService.NewExportTektonPipelineRunLogsOptions("PipelineID", "RunID", nil))
+		Expect(err).ToNot(BeNil())
+
+		var buffer bytes.Buffer
+		_, err = cdTektonPipelineService.ExportTektonPipelineRunLogs(
+			cdTektonPipelineService.NewExportTektonPipelineRunLogsOptions("PipelineID", "RunID", &buffer).SetFormat("rar"))
+		Expect(err).ToNot(BeNil())
+
+		_, err = cdTektonPipelineService.ExportTektonPipelineRunLogs(
+			cdTektonPipelineService.NewExportTektonPipelineRunLogsOptions("OtherPipelineID", "RunID", &buffer))
+		Expect(err).ToNot(BeNil())
+		Expect(buffer.Len()).To(BeZero())
+	})
+})
@@ -0,0 +1,345 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultFollowPollInterval is the delay between two polls of a PipelineRunLogFollower when none is configured.
+const DefaultFollowPollInterval = 2 * time.Second
+
+// FollowTektonPipelineRunLogsOptions : The options used to create a PipelineRunLogFollower.
+type FollowTektonPipelineRunLogsOptions struct {
+	// The Tekton pipeline ID.
+	PipelineID *string `json:"pipeline_id" validate:"required,ne="`
+
+	// The Tekton pipeline run ID.
+	ID *string `json:"id" validate:"required,ne="`
+
+	// Delay between two polls. Defaults to DefaultFollowPollInterval.
+	PollInterval time.Duration
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewFollowTektonPipelineRunLogsOptions : Instantiate FollowTektonPipelineRunLogsOptions
+func (*CdTektonPipelineV2) NewFollowTektonPipelineRunLogsOptions(pipelineID string, id string) *FollowTektonPipelineRunLogsOptions {
+	return &FollowTektonPipelineRunLogsOptions{
+		PipelineID: core.StringPtr(pipelineID),
+		ID:         core.StringPtr(id),
+	}
+}
+
+// SetPipelineID : Allow user to set PipelineID
+func (_options *FollowTektonPipelineRunLogsOptions) SetPipelineID(pipelineID string) *FollowTektonPipelineRunLogsOptions {
+	_options.PipelineID = core.StringPtr(pipelineID)
+	return _options
+}
+
+// SetID : Allow user to set ID
+func (_options *FollowTektonPipelineRunLogsOptions) SetID(id string) *FollowTektonPipelineRunLogsOptions {
+	_options.ID = core.StringPtr(id)
+	return _options
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (_options *FollowTektonPipelineRunLogsOptions) SetPollInterval(pollInterval time.Duration) *FollowTektonPipelineRunLogsOptions {
+	_options.PollInterval = pollInterval
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *FollowTektonPipelineRunLogsOptions) SetHeaders(param map[string]string) *FollowTektonPipelineRunLogsOptions {
+	options.Headers = param
+	return options
+}
+
+// PipelineRunLogChunk : Log content of a single step that was not returned by a previous poll.
+type PipelineRunLogChunk struct {
+	// Step log ID.
+	LogID string
+
+	// <podName>/<containerName> of the step log.
+	Name string
+
+	// The new log content.
+	Data string
+}
+
+// PipelineRunLogLine : A single complete line of a step log.
+type PipelineRunLogLine struct {
+	// <podName>/<containerName> of the step log.
+	Name string
+
+	// The line content, without the trailing newline.
+	Text string
+}
+
+// String returns the line prefixed with the name of its step log.
+func (line PipelineRunLogLine) String() string {
+	return fmt.Sprintf("[%s] %s", line.Name, line.Text)
+}
+
+// PipelineRunLogFollower can be used to tail the logs of a running pipeline run. Each poll returns only the log
+// content that was not returned before, including the logs of steps that started since the previous poll. The
+// follower stops by itself once the run has reached a terminal status and its final logs have been returned.
+type PipelineRunLogFollower struct {
+	hasNext bool
+	options *FollowTektonPipelineRunLogsOptions
+	client  *CdTektonPipelineV2
+	offsets map[string]int
+	status  string
+}
+
+// NewPipelineRunLogFollower returns a new PipelineRunLogFollower instance.
+func (cdTektonPipeline *CdTektonPipelineV2) NewPipelineRunLogFollower(options *FollowTektonPipelineRunLogsOptions) (follower *PipelineRunLogFollower, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(options, "options")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy FollowTektonPipelineRunLogsOptions = *options
+	if optionsCopy.PollInterval <= 0 {
+		optionsCopy.PollInterval = DefaultFollowPollInterval
+	}
+	follower = &PipelineRunLogFollower{
+		hasNext: true,
+		options: &optionsCopy,
+		client:  cdTektonPipeline,
+		offsets: make(map[string]int),
+	}
+	return
+}
+
+// HasNext returns true if the pipeline run may still produce new log content.
+func (follower *PipelineRunLogFollower) HasNext() bool {
+	return follower.hasNext
+}
+
+// Status returns the pipeline run status observed by the most recent poll.
+func (follower *PipelineRunLogFollower) Status() string {
+	return follower.status
+}
+
+// GetNextWithContext polls the pipeline run once and returns the log content that is new since the previous poll,
+// in the order in which the service lists the step logs.
+func (follower *PipelineRunLogFollower) GetNextWithContext(ctx context.Context) (chunks []PipelineRunLogChunk, err error) {
+	if !follower.HasNext() {
+		return nil, fmt.Errorf("no more results available")
+	}
+
+	// The status is read before the logs so that logs fetched after a terminal status are known to be complete.
+	run, _, err := follower.client.GetTektonPipelineRunWithContext(ctx, &GetTektonPipelineRunOptions{
+		PipelineID: follower.options.PipelineID,
+		ID:         follower.options.ID,
+		Headers:    follower.options.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "follow-get-run-error")
+		return
+	}
+	status := ""
+	if run.Status != nil {
+		status = *run.Status
+	}
+
+	logs, _, err := follower.client.GetTektonPipelineRunLogsWithContext(ctx, &GetTektonPipelineRunLogsOptions{
+		PipelineID: follower.options.PipelineID,
+		ID:         follower.options.ID,
+		Headers:    follower.options.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "follow-get-logs-error")
+		return
+	}
+
+	// Offsets are only advanced once every step log has been fetched, so a failed poll can be retried safely.
+	offsets := make(map[string]int)
+	var polled []PipelineRunLogChunk
+	for _, log := range logs.Logs {
+		if log.ID == nil {
+			continue
+		}
+		var stepLog *StepLog
+		stepLog, _, err = follower.client.GetTektonPipelineRunLogContentWithContext(ctx, &GetTektonPipelineRunLogContentOptions{
+			PipelineID:    follower.options.PipelineID,
+			PipelineRunID: follower.options.ID,
+			ID:            log.ID,
+			Headers:       follower.options.Headers,
+		})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "follow-get-log-content-error")
+			return
+		}
+		if stepLog.Data == nil {
+			continue
+		}
+
+		data := *stepLog.Data
+		offset := follower.offsets[*log.ID]
+		if len(data) <= offset {
+			continue
+		}
+		offsets[*log.ID] = len(data)
+		polled = append(polled, PipelineRunLogChunk{
+			LogID: *log.ID,
+			Name:  core.StringNilMapper(log.Name),
+			Data:  data[offset:],
+		})
+	}
+
+	for logID, offset := range offsets {
+		follower.offsets[logID] = offset
+	}
+	chunks = polled
+	follower.status = status
+	if ParseRunStatus(status).IsTerminal() {
+		follower.hasNext = false
+	}
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (follower *PipelineRunLogFollower) GetNext() (chunks []PipelineRunLogChunk, err error) {
+	chunks, err = follower.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// Lines polls the pipeline run until it reaches a terminal status and emits every complete log line on the returned
+// channel. A trailing line without a newline is emitted once the run has finished. Both channels are closed when
+// following stops; at most one error is sent.
+func (follower *PipelineRunLogFollower) Lines(ctx context.Context) (<-chan PipelineRunLogLine, <-chan error) {
+	lines := make(chan PipelineRunLogLine)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(lines)
+
+		// Incomplete trailing lines are kept per step log until the rest of the line arrives.
+		partial := make(map[string]*PipelineRunLogLine)
+		var order []string
+		emit := func(line PipelineRunLogLine) bool {
+			select {
+			case lines <- line:
+				return true
+			case <-ctx.Done():
+				errs <- core.SDKErrorf(ctx.Err(), "", "follow-cancelled", common.GetComponentInfo())
+				return false
+			}
+		}
+
+		for follower.HasNext() {
+			chunks, err := follower.GetNextWithContext(ctx)
+			if err != nil {
+				errs <- err
+				return
+			}
+			for _, chunk := range chunks {
+				pending, seen := partial[chunk.LogID]
+				if !seen {
+					pending = &PipelineRunLogLine{Name: chunk.Name}
+					partial[chunk.LogID] = pending
+					order = append(order, chunk.LogID)
+				}
+				text := pending.Text + chunk.Data
+				for {
+					newline := strings.IndexByte(text, '\n')
+					if newline < 0 {
+						break
+					}
+					if !emit(PipelineRunLogLine{Name: chunk.Name, Text: strings.TrimSuffix(text[:newline], "\r")}) {
+						return
+					}
+					text = text[newline+1:]
+				}
+				pending.Text = text
+			}
+
+			if !follower.HasNext() {
+				break
+			}
+			timer := time.NewTimer(follower.options.PollInterval)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				errs <- core.SDKErrorf(ctx.Err(), "", "follow-cancelled", common.GetComponentInfo())
+				return
+			case <-timer.C:
+			}
+		}
+
+		for _, logID := range order {
+			if pending := partial[logID]; pending.Text != "" {
+				if !emit(*pending) {
+					return
+				}
+			}
+		}
+	}()
+
+	return lines, errs
+}
+
+// Reader returns a reader that yields the prefixed log lines of the pipeline run, one per line, in the same order
+// as Lines. The reader reaches EOF once the run has finished; closing it stops following.
+func (follower *PipelineRunLogFollower) Reader(ctx context.Context) io.ReadCloser {
+	ctx, cancel := context.WithCancel(ctx)
+	pipeReader, pipeWriter := io.Pipe()
+	lines, errs := follower.Lines(ctx)
+
+	go func() {
+		defer cancel()
+		for line := range lines {
+			if _, err := io.WriteString(pipeWriter, line.String()+"\n"); err != nil {
+				for range lines {
+				}
+				return
+			}
+		}
+		pipeWriter.CloseWithError(<-errs)
+	}()
+
+	return &followerReader{PipeReader: pipeReader, cancel: cancel}
+}
+
+// followerReader stops the underlying follower when the reader is closed.
+type followerReader struct {
+	*io.PipeReader
+	cancel context.CancelFunc
+}
+
+func (reader *followerReader) Close() error {
+	reader.cancel()
+	return reader.PipeReader.Close()
+}
@@ -0,0 +1,191 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// logPoll describes what the mock server returns for one poll of a pipeline run: its status and the content of
+// every step log, keyed by <podName>/<containerName>, in listing order.
+type logPoll struct {
+	status string
+	logs   [][2]string
+
+	// Names of the logs listed without an ID.
+	withoutID map[string]bool
+}
+
+// newLogPollServer returns a mock server which advances to the next logPoll each time the pipeline run itself is
+// fetched, repeating the last one once the sequence is exhausted.
+func newLogPollServer(polls ...logPoll) *httptest.Server {
+	var mutex sync.Mutex
+	current := -1
+	runPath := "/tekton_pipelines/PipelineID/pipeline_runs/RunID"
+
+	return httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		defer GinkgoRecover()
+
+		mutex.Lock()
+		if req.URL.EscapedPath() == runPath && current < len(polls)-1 {
+			current++
+		}
+		poll := polls[max(current, 0)]
+		mutex.Unlock()
+
+		res.Header().Set("Content-type", "application/json")
+		path := req.URL.EscapedPath()
+		switch {
+		case path == runPath:
+			fmt.Fprint(res, mockPipelineRunJSON("RunID", poll.status))
+		case path == runPath+"/logs":
+			logs := []map[string]string{}
+			for index, log := range poll.logs {
+				if poll.withoutID[log[0]] {
+					logs = append(logs, map[string]string{"name": log[0]})
+					continue
+				}
+				logs = append(logs, map[string]string{"id": fmt.Sprintf("log-%d", index), "name": log[0]})
+			}
+			Expect(json.NewEncoder(res).Encode(map[string]interface{}{"logs": logs})).To(Succeed())
+		case strings.HasPrefix(path, runPath+"/logs/log-"):
+			var index int
+			_, err := fmt.Sscanf(strings.TrimPrefix(path, runPath+"/logs/log-"), "%d", &index)
+			Expect(err).To(BeNil())
+			Expect(json.NewEncoder(res).Encode(map[string]string{"id": fmt.Sprintf("log-%d", index), "data": poll.logs[index][1]})).To(Succeed())
+		default:
+			res.WriteHeader(404)
+		}
+	}))
+}
+
+var _ = Describe(`CdTektonPipelineV2 PipelineRunLogFollower`, func() {
+	var testServer *httptest.Server
+	var cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+
+	polls := []logPoll{
+		{status: "running", logs: [][2]string{{"build-pod/step-compile", "line1\nli"}}},
+		{status: "running", logs: [][2]string{{"build-pod/step-compile", "line1\nline2\n"}, {"test-pod/step-unit", "hello"}}},
+		{status: "succeeded", logs: [][2]string{{"build-pod/step-compile", "line1\nline2\n"}, {"test-pod/step-unit", "hello\r\nworld"}}},
+	}
+
+	BeforeEach(func() {
+		testServer = newLogPollServer(polls...)
+		var serviceErr error
+		cdTektonPipelineService, serviceErr = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Invoke GetNext and receive only new log content`, func() {
+		follower, err := cdTektonPipelineService.NewPipelineRunLogFollower(
+			cdTektonPipelineService.NewFollowTektonPipelineRunLogsOptions("PipelineID", "RunID"))
+		Expect(err).To(BeNil())
+
+		chunks, err := follower.GetNext()
+		Expect(err).To(BeNil())
+		Expect(chunks).To(Equal([]cdtektonpipelinev2.PipelineRunLogChunk{
+			{LogID: "log-0", Name: "build-pod/step-compile", Data: "line1\nli"},
+		}))
+		Expect(follower.HasNext()).To(BeTrue())
+
+		chunks, err = follower.GetNext()
+		Expect(err).To(BeNil())
+		Expect(chunks).To(Equal([]cdtektonpipelinev2.PipelineRunLogChunk{
+			{LogID: "log-0", Name: "build-pod/step-compile", Data: "ne2\n"},
+			{LogID: "log-1", Name: "test-pod/step-unit", Data: "hello"},
+		}))
+
+		chunks, err = follower.GetNext()
+		Expect(err).To(BeNil())
+		Expect(chunks).To(Equal([]cdtektonpipelinev2.PipelineRunLogChunk{
+			{LogID: "log-1", Name: "test-pod/step-unit", Data: "\r\nworld"},
+		}))
+		Expect(follower.HasNext()).To(BeFalse())
+		Expect(follower.Status()).To(Equal("succeeded"))
+
+		_, err = follower.GetNext()
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Invoke Lines and receive every line exactly once`, func() {
+		follower, err := cdTektonPipelineService.NewPipelineRunLogFollower(
+			cdTektonPipelineService.NewFollowTektonPipelineRunLogsOptions("PipelineID", "RunID").SetPollInterval(time.Millisecond))
+		Expect(err).To(BeNil())
+
+		lines, errs := follower.Lines(context.Background())
+		var received []string
+		for line := range lines {
+			received = append(received, line.String())
+		}
+		Expect(<-errs).To(BeNil())
+		Expect(received).To(Equal([]string{
+			"[build-pod/step-compile] line1",
+			"[build-pod/step-compile] line2",
+			"[test-pod/step-unit] hello",
+			"[test-pod/step-unit] world",
+		}))
+	})
+	It(`Invoke Reader and read the prefixed log`, func() {
+		follower, err := cdTektonPipelineService.NewPipelineRunLogFollower(
+			cdTektonPipelineService.NewFollowTektonPipelineRunLogsOptions("PipelineID", "RunID").SetPollInterval(time.Millisecond))
+		Expect(err).To(BeNil())
+
+		reader := follower.Reader(context.Background())
+		defer reader.Close()
+		content, err := io.ReadAll(reader)
+		Expect(err).To(BeNil())
+		Expect(string(content)).To(Equal("[build-pod/step-compile] line1\n[build-pod/step-compile] line2\n[test-pod/step-unit] hello\n[test-pod/step-unit] world\n"))
+	})
+	It(`Invoke Lines with cancelled context`, func() {
+		follower, err := cdTektonPipelineService.NewPipelineRunLogFollower(
+			cdTektonPipelineService.NewFollowTektonPipelineRunLogsOptions("PipelineID", "RunID").SetPollInterval(time.Hour))
+		Expect(err).To(BeNil())
+
+		ctx, cancelFunc := context.WithCancel(context.Background())
+		lines, errs := follower.Lines(ctx)
+		Expect((<-lines).Text).To(Equal("line1"))
+		cancelFunc()
+		for range lines {
+		}
+		Expect(<-errs).ToNot(BeNil())
+	})
+	It(`Invoke NewPipelineRunLogFollower with error: Operation validation error`, func() {
+		_, err := cdTektonPipelineService.NewPipelineRunLogFollower(nil)
+		Expect(err).ToNot(BeNil())
+
+		_, err = cdTektonPipelineService.NewPipelineRunLogFollower(new(cdtektonpipelinev2.FollowTektonPipelineRunLogsOptions))
+		Expect(err).ToNot(BeNil())
+	})
+})
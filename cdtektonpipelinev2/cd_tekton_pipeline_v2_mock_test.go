@@ -0,0 +1,284 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// mockRun is a pipeline run held by a mockRunStore.
+type mockRun struct {
+	ID           string
+	Status       string
+	Trigger      string
+	Description  string
+	ErrorMessage string
+	Worker       string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	Properties   map[string]interface{}
+}
+
+// mockRunStore is an in-memory pipeline run service supporting the run operations used by the run helpers:
+// list (with paging and filters), get, create, rerun, cancel and delete.
+type mockRunStore struct {
+	mutex    sync.Mutex
+	runs     map[string][]*mockRun
+	requests []string
+	nextID   int
+
+	// Optional hook invoked, under the store lock, for every created or rerun run.
+	onCreate func(pipelineID string, run *mockRun)
+
+	// Optional hooks invoked, under the store lock, before every request is served. A hook returns true when it
+	// wrote the response itself.
+	intercepts []func(res http.ResponseWriter, req *http.Request) bool
+
+	// IDs of the runs whose cancel, rerun and delete requests fail.
+	failing map[string]bool
+}
+
+func newMockRunStore() *mockRunStore {
+	return &mockRunStore{runs: make(map[string][]*mockRun)}
+}
+
+// add stores a run; runs must be added oldest first.
+func (store *mockRunStore) add(pipelineID string, run mockRun) *mockRun {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	if run.CreatedAt.IsZero() {
+		run.CreatedAt = time.Date(2026, 1, 1, 0, 0, len(store.runs[pipelineID]), 0, time.UTC)
+	}
+	if run.Worker == "" {
+		run.Worker = "public"
+	}
+	stored := &run
+	store.runs[pipelineID] = append([]*mockRun{stored}, store.runs[pipelineID]...)
+	return stored
+}
+
+func (store *mockRunStore) find(runID string) (string, int) {
+	for pipelineID, runs := range store.runs {
+		for index, run := range runs {
+			if run.ID == runID {
+				return pipelineID, index
+			}
+		}
+	}
+	return "", -1
+}
+
+func (store *mockRunStore) setStatus(runID string, status string) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	pipelineID, index := store.find(runID)
+	store.runs[pipelineID][index].Status = status
+}
+
+func (store *mockRunStore) remove(runID string) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	pipelineID, index := store.find(runID)
+	store.runs[pipelineID] = append(store.runs[pipelineID][:index], store.runs[pipelineID][index+1:]...)
+}
+
+// intercept registers a hook invoked, under the store lock, before every request is served.
+func (store *mockRunStore) intercept(hook func(res http.ResponseWriter, req *http.Request) bool) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	store.intercepts = append(store.intercepts, hook)
+}
+
+func (store *mockRunStore) requestLog() []string {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	return append([]string(nil), store.requests...)
+}
+
+func (store *mockRunStore) toJSON(pipelineID string, run *mockRun) map[string]interface{} {
+	body := map[string]interface{}{
+		"id":                run.ID,
+		"status":            run.Status,
+		"definition_id":     "DefinitionID",
+		"worker":            map[string]interface{}{"id": run.Worker, "name": run.Worker},
+		"pipeline_id":       pipelineID,
+		"listener_name":     "listener",
+		"trigger":           map[string]interface{}{"type": "manual", "name": run.Trigger},
+		"event_params_blob": "{}",
+		"created_at":        run.CreatedAt.Format(time.RFC3339Nano),
+		"run_url":           "https://cloud.ibm.com/devops/pipelines/tekton/" + pipelineID + "/runs/" + run.ID,
+	}
+	if !run.UpdatedAt.IsZero() {
+		body["updated_at"] = run.UpdatedAt.Format(time.RFC3339Nano)
+	}
+	if run.Description != "" {
+		body["description"] = run.Description
+	}
+	if run.ErrorMessage != "" {
+		body["error_message"] = run.ErrorMessage
+	}
+	var properties []map[string]interface{}
+	for name, value := range run.Properties {
+		properties = append(properties, map[string]interface{}{"name": name, "value": value, "type": "text"})
+	}
+	if properties != nil {
+		body["properties"] = properties
+	}
+	return body
+}
+
+func (store *mockRunStore) createRun(pipelineID string, run *mockRun) *mockRun {
+	store.nextID++
+	run.ID = fmt.Sprintf("new-%d", store.nextID)
+	run.Status = "pending"
+	run.Worker = "public"
+	run.CreatedAt = time.Date(2026, 2, 1, 0, 0, store.nextID, 0, time.UTC)
+	if store.onCreate != nil {
+		store.onCreate(pipelineID, run)
+	}
+	store.runs[pipelineID] = append([]*mockRun{run}, store.runs[pipelineID]...)
+	return run
+}
+
+// createRequested stores the run described by a create request body.
+func (store *mockRunStore) createRequested(pipelineID string, req *http.Request) *mockRun {
+	var body map[string]interface{}
+	Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+	run := &mockRun{}
+	run.Trigger, _ = body["trigger_name"].(string)
+	run.Description, _ = body["description"].(string)
+	run.Properties, _ = body["trigger_properties"].(map[string]interface{})
+	return store.createRun(pipelineID, run)
+}
+
+// writeMockJSON writes a JSON response.
+func writeMockJSON(res http.ResponseWriter, status int, body interface{}) {
+	res.Header().Set("Content-type", "application/json")
+	res.WriteHeader(status)
+	Expect(json.NewEncoder(res).Encode(body)).To(Succeed())
+}
+
+// writeMockError writes an error response.
+func writeMockError(res http.ResponseWriter, status int, code string) {
+	writeMockJSON(res, status, map[string]interface{}{"errors": []map[string]string{{"code": code, "message": "request failed"}}})
+}
+
+func (store *mockRunStore) server() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		defer GinkgoRecover()
+
+		store.mutex.Lock()
+		defer store.mutex.Unlock()
+		store.requests = append(store.requests, req.Method+" "+req.URL.Path)
+		for _, hook := range store.intercepts {
+			if hook(res, req) {
+				return
+			}
+		}
+
+		segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+		if len(segments) < 3 || segments[0] != "tekton_pipelines" || segments[2] != "pipeline_runs" {
+			res.WriteHeader(404)
+			return
+		}
+		pipelineID := segments[1]
+		writeJSON := func(status int, body interface{}) {
+			writeMockJSON(res, status, body)
+		}
+
+		if len(segments) == 3 && req.Method == "GET" {
+			var matching []*mockRun
+			for _, run := range store.runs[pipelineID] {
+				if status := req.URL.Query().Get("status"); status != "" && run.Status != status {
+					continue
+				}
+				if trigger := req.URL.Query().Get("trigger.name"); trigger != "" && run.Trigger != trigger {
+					continue
+				}
+				matching = append(matching, run)
+			}
+			start, _ := strconv.Atoi(req.URL.Query().Get("start"))
+			limit, err := strconv.Atoi(req.URL.Query().Get("limit"))
+			if err != nil || limit <= 0 {
+				limit = 50
+			}
+			end := min(start+limit, len(matching))
+			runs := []map[string]interface{}{}
+			for _, run := range matching[min(start, end):end] {
+				runs = append(runs, store.toJSON(pipelineID, run))
+			}
+			body := map[string]interface{}{
+				"pipeline_runs": runs,
+				"limit":         limit,
+				"first":         map[string]string{"href": "first"},
+			}
+			if end < len(matching) {
+				body["next"] = map[string]string{"href": fmt.Sprintf("%s?start=%d&limit=%d", req.URL.Path, end, limit)}
+			}
+			writeJSON(200, body)
+			return
+		}
+
+		if len(segments) == 3 && req.Method == "POST" {
+			writeJSON(201, store.toJSON(pipelineID, store.createRequested(pipelineID, req)))
+			return
+		}
+
+		var run *mockRun
+		for _, candidate := range store.runs[pipelineID] {
+			if candidate.ID == segments[3] {
+				run = candidate
+			}
+		}
+		if run == nil {
+			writeMockError(res, 404, "not_found")
+			return
+		}
+
+		if store.failing[run.ID] && (req.Method == "DELETE" || len(segments) == 5) {
+			writeMockError(res, 500, "internal_error")
+			return
+		}
+
+		switch {
+		case len(segments) == 4 && req.Method == "GET":
+			writeJSON(200, store.toJSON(pipelineID, run))
+		case len(segments) == 4 && req.Method == "DELETE":
+			_, index := store.find(run.ID)
+			store.runs[pipelineID] = append(store.runs[pipelineID][:index], store.runs[pipelineID][index+1:]...)
+			res.WriteHeader(204)
+		case len(segments) == 5 && segments[4] == "cancel":
+			run.Status = "cancelled"
+			writeJSON(202, store.toJSON(pipelineID, run))
+		case len(segments) == 5 && segments[4] == "rerun":
+			rerun := &mockRun{Trigger: run.Trigger, Description: run.Description, Properties: run.Properties}
+			writeJSON(201, store.toJSON(pipelineID, store.createRun(pipelineID, rerun)))
+		default:
+			res.WriteHeader(404)
+		}
+	}))
+}
@@ -0,0 +1,291 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultRerunDelay is the delay used by SuperviseTektonPipelineRun before starting a rerun when RerunDelay is not set.
+const DefaultRerunDelay = 30 * time.Second
+
+// PipelineRunRerunPredicate decides whether a run that ended in `failed` or `error` should be rerun. The headers are the
+// ones set on the SuperviseTektonPipelineRun options, to be set on the API requests of the predicate.
+type PipelineRunRerunPredicate func(ctx context.Context, cdTektonPipeline *CdTektonPipelineV2, run *PipelineRun, headers map[string]string) (bool, error)
+
+// PipelineRunRerunCallback is invoked by SuperviseTektonPipelineRun each time a failed run is rerun.
+type PipelineRunRerunCallback func(failedRun *PipelineRun, rerun *PipelineRun)
+
+// RerunOnErrorMessage returns a predicate that reruns a failed run when its error message matches the pattern.
+func RerunOnErrorMessage(pattern *regexp.Regexp) PipelineRunRerunPredicate {
+	return func(_ context.Context, _ *CdTektonPipelineV2, run *PipelineRun, _ map[string]string) (bool, error) {
+		return run.ErrorMessage != nil && pattern.MatchString(*run.ErrorMessage), nil
+	}
+}
+
+// RerunOnLogPattern returns a predicate that reruns a failed run when one of its step logs matches the pattern. The
+// step logs listed without an ID cannot be fetched and are skipped.
+func RerunOnLogPattern(pattern *regexp.Regexp) PipelineRunRerunPredicate {
+	return func(ctx context.Context, cdTektonPipeline *CdTektonPipelineV2, run *PipelineRun, headers map[string]string) (bool, error) {
+		logs, _, err := cdTektonPipeline.GetTektonPipelineRunLogsWithContext(ctx, &GetTektonPipelineRunLogsOptions{
+			PipelineID: run.PipelineID,
+			ID:         run.ID,
+			Headers:    headers,
+		})
+		if err != nil {
+			return false, core.RepurposeSDKProblem(err, "rerun-get-logs-error")
+		}
+		for _, log := range logs.Logs {
+			if log.ID == nil {
+				continue
+			}
+			stepLog, _, err := cdTektonPipeline.GetTektonPipelineRunLogContentWithContext(ctx, &GetTektonPipelineRunLogContentOptions{
+				PipelineID:    run.PipelineID,
+				PipelineRunID: run.ID,
+				ID:            log.ID,
+				Headers:       headers,
+			})
+			if err != nil {
+				return false, core.RepurposeSDKProblem(err, "rerun-get-log-content-error")
+			}
+			if pattern.MatchString(core.StringNilMapper(stepLog.Data)) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+}
+
+// SuperviseTektonPipelineRunOptions : The SuperviseTektonPipelineRun options.
+type SuperviseTektonPipelineRunOptions struct {
+	// The Tekton pipeline ID.
+	PipelineID *string `json:"pipeline_id" validate:"required,ne="`
+
+	// The ID of the first pipeline run to supervise.
+	ID *string `json:"id" validate:"required,ne="`
+
+	// Maximum number of reruns. Zero only waits for the first run.
+	MaxReruns int `validate:"gte=0"`
+
+	// Delay between the end of a failed run and its rerun. Defaults to DefaultRerunDelay when nil; zero reruns right
+	// away.
+	RerunDelay *time.Duration
+
+	// Optional predicate restricting which failures are rerun. When nil, every run ending in `failed` or `error` is
+	// rerun.
+	ShouldRerun PipelineRunRerunPredicate
+
+	// Optional callback invoked after each rerun was started.
+	OnRerun PipelineRunRerunCallback
+
+	// Delay before the second poll of each run. Defaults to DefaultWaitPollInterval.
+	PollInterval time.Duration
+
+	// Upper bound for the delay between two polls of a run. Defaults to DefaultWaitMaxPollInterval.
+	MaxPollInterval time.Duration
+
+	// Maximum time to wait for each run. When zero, only the deadline of the context applies.
+	RunTimeout time.Duration
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewSuperviseTektonPipelineRunOptions : Instantiate SuperviseTektonPipelineRunOptions
+func (*CdTektonPipelineV2) NewSuperviseTektonPipelineRunOptions(pipelineID string, id string, maxReruns int) *SuperviseTektonPipelineRunOptions {
+	return &SuperviseTektonPipelineRunOptions{
+		PipelineID: core.StringPtr(pipelineID),
+		ID:         core.StringPtr(id),
+		MaxReruns:  maxReruns,
+	}
+}
+
+// SetPipelineID : Allow user to set PipelineID
+func (_options *SuperviseTektonPipelineRunOptions) SetPipelineID(pipelineID string) *SuperviseTektonPipelineRunOptions {
+	_options.PipelineID = core.StringPtr(pipelineID)
+	return _options
+}
+
+// SetID : Allow user to set ID
+func (_options *SuperviseTektonPipelineRunOptions) SetID(id string) *SuperviseTektonPipelineRunOptions {
+	_options.ID = core.StringPtr(id)
+	return _options
+}
+
+// SetMaxReruns : Allow user to set MaxReruns
+func (_options *SuperviseTektonPipelineRunOptions) SetMaxReruns(maxReruns int) *SuperviseTektonPipelineRunOptions {
+	_options.MaxReruns = maxReruns
+	return _options
+}
+
+// SetRerunDelay : Allow user to set RerunDelay
+func (_options *SuperviseTektonPipelineRunOptions) SetRerunDelay(rerunDelay time.Duration) *SuperviseTektonPipelineRunOptions {
+	_options.RerunDelay = &rerunDelay
+	return _options
+}
+
+// SetShouldRerun : Allow user to set ShouldRerun
+func (_options *SuperviseTektonPipelineRunOptions) SetShouldRerun(shouldRerun PipelineRunRerunPredicate) *SuperviseTektonPipelineRunOptions {
+	_options.ShouldRerun = shouldRerun
+	return _options
+}
+
+// SetOnRerun : Allow user to set OnRerun
+func (_options *SuperviseTektonPipelineRunOptions) SetOnRerun(onRerun PipelineRunRerunCallback) *SuperviseTektonPipelineRunOptions {
+	_options.OnRerun = onRerun
+	return _options
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (_options *SuperviseTektonPipelineRunOptions) SetPollInterval(pollInterval time.Duration) *SuperviseTektonPipelineRunOptions {
+	_options.PollInterval = pollInterval
+	return _options
+}
+
+// SetMaxPollInterval : Allow user to set MaxPollInterval
+func (_options *SuperviseTektonPipelineRunOptions) SetMaxPollInterval(maxPollInterval time.Duration) *SuperviseTektonPipelineRunOptions {
+	_options.MaxPollInterval = maxPollInterval
+	return _options
+}
+
+// SetRunTimeout : Allow user to set RunTimeout
+func (_options *SuperviseTektonPipelineRunOptions) SetRunTimeout(runTimeout time.Duration) *SuperviseTektonPipelineRunOptions {
+	_options.RunTimeout = runTimeout
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *SuperviseTektonPipelineRunOptions) SetHeaders(param map[string]string) *SuperviseTektonPipelineRunOptions {
+	options.Headers = param
+	return options
+}
+
+// PipelineRunRerunChain : The runs observed by SuperviseTektonPipelineRun.
+type PipelineRunRerunChain struct {
+	// IDs of the supervised run followed by the IDs of its successive reruns.
+	RunIDs []string `json:"run_ids"`
+
+	// The last observed run.
+	Run *PipelineRun `json:"run,omitempty"`
+
+	// The outcome of the last run.
+	Outcome PipelineRunOutcome `json:"outcome,omitempty"`
+}
+
+// Reruns returns the number of reruns started.
+func (chain *PipelineRunRerunChain) Reruns() int {
+	return len(chain.RunIDs) - 1
+}
+
+// SuperviseTektonPipelineRun : Rerun a pipeline run until it succeeds
+// This waits for the pipeline run identified by `{id}` and reruns it each time it ends in `failed` or `error`, up to
+// the configured number of reruns. It returns the chain of run IDs together with the last run and its outcome.
+func (cdTektonPipeline *CdTektonPipelineV2) SuperviseTektonPipelineRun(superviseTektonPipelineRunOptions *SuperviseTektonPipelineRunOptions) (result *PipelineRunRerunChain, err error) {
+	result, err = cdTektonPipeline.SuperviseTektonPipelineRunWithContext(context.Background(), superviseTektonPipelineRunOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// SuperviseTektonPipelineRunWithContext is an alternate form of the SuperviseTektonPipelineRun method which supports a Context parameter.
+// When an error occurs after the first run was observed, the chain built so far is returned together with the error.
+func (cdTektonPipeline *CdTektonPipelineV2) SuperviseTektonPipelineRunWithContext(ctx context.Context, superviseTektonPipelineRunOptions *SuperviseTektonPipelineRunOptions) (result *PipelineRunRerunChain, err error) {
+	err = core.ValidateNotNil(superviseTektonPipelineRunOptions, "superviseTektonPipelineRunOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(superviseTektonPipelineRunOptions, "superviseTektonPipelineRunOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	rerunDelay := DefaultRerunDelay
+	if superviseTektonPipelineRunOptions.RerunDelay != nil {
+		rerunDelay = *superviseTektonPipelineRunOptions.RerunDelay
+	}
+
+	runID := *superviseTektonPipelineRunOptions.ID
+	result = &PipelineRunRerunChain{RunIDs: []string{runID}}
+	for {
+		run, outcome, waitErr := cdTektonPipeline.WaitForTektonPipelineRunWithContext(ctx, &WaitForTektonPipelineRunOptions{
+			PipelineID:      superviseTektonPipelineRunOptions.PipelineID,
+			ID:              core.StringPtr(runID),
+			PollInterval:    superviseTektonPipelineRunOptions.PollInterval,
+			MaxPollInterval: superviseTektonPipelineRunOptions.MaxPollInterval,
+			Timeout:         superviseTektonPipelineRunOptions.RunTimeout,
+			Headers:         superviseTektonPipelineRunOptions.Headers,
+		})
+		if waitErr != nil {
+			err = core.RepurposeSDKProblem(waitErr, "supervise-wait-error")
+			return
+		}
+		result.Run = run
+		result.Outcome = outcome
+
+		if outcome != PipelineRunOutcomeFailedConst && outcome != PipelineRunOutcomeErrorConst {
+			return
+		}
+		if result.Reruns() >= superviseTektonPipelineRunOptions.MaxReruns {
+			return
+		}
+		if superviseTektonPipelineRunOptions.ShouldRerun != nil {
+			rerun, predicateErr := superviseTektonPipelineRunOptions.ShouldRerun(ctx, cdTektonPipeline, run, superviseTektonPipelineRunOptions.Headers)
+			if predicateErr != nil {
+				err = core.RepurposeSDKProblem(predicateErr, "supervise-predicate-error")
+				return
+			}
+			if !rerun {
+				return
+			}
+		}
+
+		timer := time.NewTimer(rerunDelay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			err = core.SDKErrorf(ctx.Err(), "", "supervise-cancelled", common.GetComponentInfo())
+			return
+		case <-timer.C:
+		}
+
+		rerun, _, rerunErr := cdTektonPipeline.RerunTektonPipelineRunWithContext(ctx, &RerunTektonPipelineRunOptions{
+			PipelineID: superviseTektonPipelineRunOptions.PipelineID,
+			ID:         core.StringPtr(runID),
+			Headers:    superviseTektonPipelineRunOptions.Headers,
+		})
+		if rerunErr != nil {
+			err = core.RepurposeSDKProblem(rerunErr, "supervise-rerun-error")
+			return
+		}
+		if rerun == nil || rerun.ID == nil {
+			err = core.SDKErrorf(nil, fmt.Sprintf("the rerun of run '%s' has no ID", runID), "supervise-rerun-missing-id", common.GetComponentInfo())
+			return
+		}
+		runID = *rerun.ID
+		result.RunIDs = append(result.RunIDs, runID)
+		if superviseTektonPipelineRunOptions.OnRerun != nil {
+			superviseTektonPipelineRunOptions.OnRerun(run, rerun)
+		}
+	}
+}
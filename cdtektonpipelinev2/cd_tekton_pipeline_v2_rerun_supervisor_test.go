@@ -0,0 +1,176 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CdTektonPipelineV2 SuperviseTektonPipelineRun`, func() {
+	var store *mockRunStore
+	var testServer *httptest.Server
+	var cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+
+	BeforeEach(func() {
+		store = newMockRunStore()
+		store.add("PipelineID", mockRun{ID: "RunID", Status: "failed", ErrorMessage: "connection reset by peer"})
+		testServer = store.server()
+		var serviceErr error
+		cdTektonPipelineService, serviceErr = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	// finishReruns makes reruns end immediately with the given statuses, in order.
+	finishReruns := func(statuses ...string) {
+		store.onCreate = func(_ string, run *mockRun) {
+			if len(statuses) > 0 {
+				run.Status = statuses[0]
+				statuses = statuses[1:]
+			}
+		}
+	}
+	newOptions := func(maxReruns int) *cdtektonpipelinev2.SuperviseTektonPipelineRunOptions {
+		return cdTektonPipelineService.NewSuperviseTektonPipelineRunOptions("PipelineID", "RunID", maxReruns).
+			SetRerunDelay(time.Millisecond).
+			SetPollInterval(time.Millisecond)
+	}
+
+	It(`Invoke SuperviseTektonPipelineRun until a rerun succeeds`, func() {
+		finishReruns("error", "succeeded")
+		var reruns []string
+		chain, err := cdTektonPipelineService.SuperviseTektonPipelineRun(newOptions(3).
+			SetRerunDelay(0).
+			SetOnRerun(func(failedRun *cdtektonpipelinev2.PipelineRun, rerun *cdtektonpipelinev2.PipelineRun) {
+				reruns = append(reruns, *failedRun.ID+"->"+*rerun.ID)
+			}))
+		Expect(err).To(BeNil())
+		Expect(chain.RunIDs).To(Equal([]string{"RunID", "new-1", "new-2"}))
+		Expect(chain.Reruns()).To(Equal(2))
+		Expect(chain.Outcome).To(Equal(cdtektonpipelinev2.PipelineRunOutcomeSucceededConst))
+		Expect(*chain.Run.ID).To(Equal("new-2"))
+		Expect(reruns).To(Equal([]string{"RunID->new-1", "new-1->new-2"}))
+		Expect(store.requestLog()).To(ContainElement("POST /tekton_pipelines/PipelineID/pipeline_runs/new-1/rerun"))
+	})
+	It(`Invoke SuperviseTektonPipelineRun until the reruns are exhausted`, func() {
+		finishReruns("failed", "failed", "failed")
+		chain, err := cdTektonPipelineService.SuperviseTektonPipelineRun(newOptions(2))
+		Expect(err).To(BeNil())
+		Expect(chain.RunIDs).To(Equal([]string{"RunID", "new-1", "new-2"}))
+		Expect(chain.Outcome).To(Equal(cdtektonpipelinev2.PipelineRunOutcomeFailedConst))
+
+		chain, err = cdTektonPipelineService.SuperviseTektonPipelineRun(newOptions(0))
+		Expect(err).To(BeNil())
+		Expect(chain.RunIDs).To(Equal([]string{"RunID"}))
+	})
+	It(`Invoke SuperviseTektonPipelineRun without rerunning cancelled or unmatched runs`, func() {
+		store.setStatus("RunID", "cancelled")
+		chain, err := cdTektonPipelineService.SuperviseTektonPipelineRun(newOptions(3))
+		Expect(err).To(BeNil())
+		Expect(chain.RunIDs).To(Equal([]string{"RunID"}))
+		Expect(chain.Outcome).To(Equal(cdtektonpipelinev2.PipelineRunOutcomeCancelledConst))
+
+		store.setStatus("RunID", "failed")
+		chain, err = cdTektonPipelineService.SuperviseTektonPipelineRun(newOptions(3).
+			SetShouldRerun(cdtektonpipelinev2.RerunOnErrorMessage(regexp.MustCompile(`timeout`))))
+		Expect(err).To(BeNil())
+		Expect(chain.RunIDs).To(Equal([]string{"RunID"}))
+
+		finishReruns("succeeded")
+		chain, err = cdTektonPipelineService.SuperviseTektonPipelineRun(newOptions(3).
+			SetShouldRerun(cdtektonpipelinev2.RerunOnErrorMessage(regexp.MustCompile(`connection reset`))))
+		Expect(err).To(BeNil())
+		Expect(chain.RunIDs).To(Equal([]string{"RunID", "new-1"}))
+	})
+	It(`Invoke SuperviseTektonPipelineRun with error: Operation validation and request error`, func() {
+		_, err := cdTektonPipelineService.SuperviseTektonPipelineRun(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.SuperviseTektonPipelineRun(newOptions(-1))
+		Expect(err).ToNot(BeNil())
+
+		chain, err := cdTektonPipelineService.SuperviseTektonPipelineRun(newOptions(1).SetShouldRerun(
+			func(context.Context, *cdtektonpipelinev2.CdTektonPipelineV2, *cdtektonpipelinev2.PipelineRun, map[string]string) (bool, error) {
+				return false, errors.New("predicate failed")
+			}))
+		Expect(err).ToNot(BeNil())
+		Expect(chain.RunIDs).To(Equal([]string{"RunID"}))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err = cdTektonPipelineService.SuperviseTektonPipelineRunWithContext(ctx, newOptions(1))
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Invoke RerunOnLogPattern to match step logs`, func() {
+		logServer := newLogPollServer(logPoll{status: "failed", logs: [][2]string{
+			{"RunID-build-pod/step-init", "error: out of memory\n"},
+			{"RunID-build-pod/step-compile", "compiling\n"},
+			{"RunID-build-pod/step-push", "error: i/o timeout\n"},
+		}, withoutID: map[string]bool{"RunID-build-pod/step-init": true}})
+		defer logServer.Close()
+		// The requests are forwarded to the log server, recording their headers.
+		var mutex sync.Mutex
+		var requestSources []string
+		logURL, err := url.Parse(logServer.URL)
+		Expect(err).To(BeNil())
+		proxy := httputil.NewSingleHostReverseProxy(logURL)
+		proxyServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			mutex.Lock()
+			requestSources = append(requestSources, req.Header.Get("X-Request-Source"))
+			mutex.Unlock()
+			proxy.ServeHTTP(res, req)
+		}))
+		defer proxyServer.Close()
+		logService, serviceErr := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           proxyServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		run := &cdtektonpipelinev2.PipelineRun{PipelineID: core.StringPtr("PipelineID"), ID: core.StringPtr("RunID")}
+		headers := map[string]string{"X-Request-Source": "supervisor"}
+
+		rerun, err := cdtektonpipelinev2.RerunOnLogPattern(regexp.MustCompile(`i/o timeout`))(context.Background(), logService, run, headers)
+		Expect(err).To(BeNil())
+		Expect(rerun).To(BeTrue())
+		rerun, err = cdtektonpipelinev2.RerunOnLogPattern(regexp.MustCompile(`out of memory`))(context.Background(), logService, run, headers)
+		Expect(err).To(BeNil())
+		Expect(rerun).To(BeFalse())
+		mutex.Lock()
+		defer mutex.Unlock()
+		Expect(requestSources).To(HaveLen(6))
+		for _, source := range requestSources {
+			Expect(source).To(Equal("supervisor"))
+		}
+	})
+})
@@ -0,0 +1,469 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+)
+
+// PipelineRunAnalytics : Reliability statistics computed over the pipeline runs created in a time window.
+type PipelineRunAnalytics struct {
+	// Start of the time window, if bounded.
+	Since *strfmt.DateTime `json:"since,omitempty"`
+
+	// End of the time window, if bounded.
+	Until *strfmt.DateTime `json:"until,omitempty"`
+
+	// Statistics over all the runs of the window.
+	Overall PipelineRunStats `json:"overall"`
+
+	// Statistics per trigger, sorted by trigger name.
+	Triggers []PipelineRunStats `json:"triggers"`
+
+	// Statistics per worker, sorted by worker name.
+	Workers []PipelineRunStats `json:"workers"`
+}
+
+// PipelineRunStats : Reliability statistics of a group of pipeline runs.
+type PipelineRunStats struct {
+	// The name of the trigger or worker, empty for the overall statistics.
+	Name string `json:"name"`
+
+	// Number of runs.
+	Total int `json:"total"`
+
+	// Number of runs per terminal status.
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+	Errored   int `json:"error"`
+	Cancelled int `json:"cancelled"`
+
+	// Number of runs that have not finished yet.
+	Active int `json:"active"`
+
+	// Ratio of succeeded runs over finished runs, cancelled runs excluded. Zero when no run finished.
+	SuccessRate float64 `json:"success_rate"`
+
+	// Time between the creation and the last update of finished runs.
+	Duration PipelineRunDurationStats `json:"duration"`
+
+	// Time between the creation of runs and the start of their execution. The service does not report when runs
+	// started, so this is only set by a PipelineRunAnalyzer observing the runs start, see
+	// PipelineRunAnalyzer.ObserveEvent, and only covers the runs observed starting.
+	QueueLatency *PipelineRunDurationStats `json:"queue_latency,omitempty"`
+
+	// Longest sequence of consecutive runs ending in `failed` or `error`. Cancelled runs do not end a streak.
+	LongestFailureStreak int `json:"longest_failure_streak"`
+}
+
+// PipelineRunDurationStats : Percentiles of a set of durations, computed with the nearest-rank method.
+type PipelineRunDurationStats struct {
+	Count int
+	P50   time.Duration
+	P90   time.Duration
+	P99   time.Duration
+	Max   time.Duration
+}
+
+// MarshalJSON encodes the durations as seconds.
+func (stats PipelineRunDurationStats) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Count int     `json:"count"`
+		P50   float64 `json:"p50_seconds"`
+		P90   float64 `json:"p90_seconds"`
+		P99   float64 `json:"p99_seconds"`
+		Max   float64 `json:"max_seconds"`
+	}{stats.Count, stats.P50.Seconds(), stats.P90.Seconds(), stats.P99.Seconds(), stats.Max.Seconds()})
+}
+
+// newPipelineRunDurationStats computes the percentiles of the durations.
+func newPipelineRunDurationStats(durations []time.Duration) (stats PipelineRunDurationStats) {
+	stats.Count = len(durations)
+	if stats.Count == 0 {
+		return
+	}
+	sorted := slices.Sorted(slices.Values(durations))
+	percentile := func(p int) time.Duration {
+		rank := (p*len(sorted) + 99) / 100
+		return sorted[max(rank, 1)-1]
+	}
+	stats.P50, stats.P90, stats.P99 = percentile(50), percentile(90), percentile(99)
+	stats.Max = sorted[len(sorted)-1]
+	return
+}
+
+// PipelineRunAnalyzer : Accumulates pipeline runs and computes PipelineRunAnalytics over them.
+type PipelineRunAnalyzer struct {
+	since     time.Time
+	until     time.Time
+	runs      map[string]PipelineRun
+	startedAt map[string]time.Time
+
+	// Whether runs are observed starting, which enables the queue latency.
+	observesStarts bool
+}
+
+// NewPipelineRunAnalyzer returns an analyzer keeping the runs created in [since, until). A zero time leaves the
+// corresponding end of the window unbounded.
+func NewPipelineRunAnalyzer(since time.Time, until time.Time) *PipelineRunAnalyzer {
+	return &PipelineRunAnalyzer{
+		since:     since,
+		until:     until,
+		runs:      make(map[string]PipelineRun),
+		startedAt: make(map[string]time.Time),
+	}
+}
+
+// AddRun adds a run to the analysis, replacing a previously added run with the same ID. It returns false if the run
+// was created outside of the time window and was ignored.
+func (analyzer *PipelineRunAnalyzer) AddRun(run *PipelineRun) bool {
+	createdAt := pipelineRunCreatedAt(run)
+	if run.ID == nil || createdAt == nil {
+		return false
+	}
+	if (!analyzer.since.IsZero() && createdAt.Before(analyzer.since)) || (!analyzer.until.IsZero() && !createdAt.Before(analyzer.until)) {
+		return false
+	}
+	analyzer.runs[*run.ID] = *run
+	return true
+}
+
+// ObserveEvent adds the run of an event reported by a PipelineRunWatcher, and enables the queue latency: the runs
+// observed transitioning to `running` contribute to it, started at their last update.
+func (analyzer *PipelineRunAnalyzer) ObserveEvent(event PipelineRunEvent) {
+	analyzer.observesStarts = true
+	if event.Type == PipelineRunEventTypeDeletedConst || event.Run == nil {
+		return
+	}
+	if analyzer.AddRun(event.Run) && event.Run.RunStatus() == RunStatusRunningConst && event.Run.UpdatedAt != nil {
+		analyzer.observeStart(*event.Run.ID, time.Time(*event.Run.UpdatedAt))
+	}
+}
+
+// observeStart records the earliest known time at which a run started running.
+func (analyzer *PipelineRunAnalyzer) observeStart(runID string, startedAt time.Time) {
+	if previous, ok := analyzer.startedAt[runID]; !ok || startedAt.Before(previous) {
+		analyzer.startedAt[runID] = startedAt
+	}
+}
+
+// Analytics computes the statistics of the runs added so far.
+func (analyzer *PipelineRunAnalyzer) Analytics() *PipelineRunAnalytics {
+	runs := slices.Collect(maps.Values(analyzer.runs))
+	sortPipelineRunsNewestFirst(runs)
+	slices.Reverse(runs)
+
+	overall := &pipelineRunStatsBuilder{}
+	triggers := make(map[string]*pipelineRunStatsBuilder)
+	workers := make(map[string]*pipelineRunStatsBuilder)
+	for index := range runs {
+		run := &runs[index]
+		var startedAt *time.Time
+		if observed, ok := analyzer.startedAt[*run.ID]; ok {
+			startedAt = &observed
+		}
+		overall.add(run, startedAt)
+		addToGroup(triggers, pipelineRunTriggerName(run), run, startedAt)
+		addToGroup(workers, pipelineRunWorkerName(run), run, startedAt)
+	}
+
+	result := &PipelineRunAnalytics{
+		Overall:  overall.build("", analyzer.observesStarts),
+		Triggers: buildGroups(triggers, analyzer.observesStarts),
+		Workers:  buildGroups(workers, analyzer.observesStarts),
+	}
+	if !analyzer.since.IsZero() {
+		since := strfmt.DateTime(analyzer.since)
+		result.Since = &since
+	}
+	if !analyzer.until.IsZero() {
+		until := strfmt.DateTime(analyzer.until)
+		result.Until = &until
+	}
+	return result
+}
+
+// pipelineRunStatsBuilder accumulates the runs of a group, in chronological order.
+type pipelineRunStatsBuilder struct {
+	stats         PipelineRunStats
+	durations     []time.Duration
+	latencies     []time.Duration
+	failureStreak int
+}
+
+func (builder *pipelineRunStatsBuilder) add(run *PipelineRun, startedAt *time.Time) {
+	builder.stats.Total++
+	status := run.RunStatus()
+	switch status {
+	case RunStatusSucceededConst:
+		builder.stats.Succeeded++
+		builder.failureStreak = 0
+	case RunStatusFailedConst, RunStatusErrorConst:
+		if status == RunStatusFailedConst {
+			builder.stats.Failed++
+		} else {
+			builder.stats.Errored++
+		}
+		builder.failureStreak++
+		builder.stats.LongestFailureStreak = max(builder.stats.LongestFailureStreak, builder.failureStreak)
+	case RunStatusCancelledConst:
+		builder.stats.Cancelled++
+	default:
+		builder.stats.Active++
+	}
+
+	createdAt := pipelineRunCreatedAt(run)
+	if status.IsTerminal() && run.UpdatedAt != nil {
+		if duration := time.Time(*run.UpdatedAt).Sub(*createdAt); duration >= 0 {
+			builder.durations = append(builder.durations, duration)
+		}
+	}
+	if startedAt != nil {
+		if latency := startedAt.Sub(*createdAt); latency >= 0 {
+			builder.latencies = append(builder.latencies, latency)
+		}
+	}
+}
+
+func (builder *pipelineRunStatsBuilder) build(name string, queueLatency bool) PipelineRunStats {
+	stats := builder.stats
+	stats.Name = name
+	if finished := stats.Succeeded + stats.Failed + stats.Errored; finished > 0 {
+		stats.SuccessRate = float64(stats.Succeeded) / float64(finished)
+	}
+	stats.Duration = newPipelineRunDurationStats(builder.durations)
+	if queueLatency {
+		latencies := newPipelineRunDurationStats(builder.latencies)
+		stats.QueueLatency = &latencies
+	}
+	return stats
+}
+
+func addToGroup(groups map[string]*pipelineRunStatsBuilder, name string, run *PipelineRun, startedAt *time.Time) {
+	builder, ok := groups[name]
+	if !ok {
+		builder = &pipelineRunStatsBuilder{}
+		groups[name] = builder
+	}
+	builder.add(run, startedAt)
+}
+
+func buildGroups(groups map[string]*pipelineRunStatsBuilder, queueLatency bool) []PipelineRunStats {
+	stats := []PipelineRunStats{}
+	for _, name := range slices.Sorted(maps.Keys(groups)) {
+		stats = append(stats, groups[name].build(name, queueLatency))
+	}
+	return stats
+}
+
+// pipelineRunWorkerName returns the name of the worker of a run, or its ID if it has no name.
+func pipelineRunWorkerName(run *PipelineRun) string {
+	if run.Worker == nil {
+		return ""
+	}
+	if run.Worker.Name != nil {
+		return *run.Worker.Name
+	}
+	return core.StringNilMapper(run.Worker.ID)
+}
+
+// WriteJSON writes the analytics as indented JSON.
+func (analytics *PipelineRunAnalytics) WriteJSON(writer io.Writer) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(analytics)
+}
+
+// pipelineRunAnalyticsCSVHeader is the header row written by PipelineRunAnalytics.WriteCSV.
+var pipelineRunAnalyticsCSVHeader = []string{
+	"group", "name", "total", "succeeded", "failed", "error", "cancelled", "active", "success_rate",
+	"duration_p50_seconds", "duration_p90_seconds", "duration_p99_seconds",
+	"queue_latency_p50_seconds", "queue_latency_p90_seconds", "queue_latency_p99_seconds",
+	"longest_failure_streak",
+}
+
+// WriteCSV writes the analytics as CSV, with one row for the overall statistics followed by one row per trigger and
+// one row per worker. The queue latency columns are empty when the queue latency is not set.
+func (analytics *PipelineRunAnalytics) WriteCSV(writer io.Writer) error {
+	csvWriter := csv.NewWriter(writer)
+	if err := csvWriter.Write(pipelineRunAnalyticsCSVHeader); err != nil {
+		return err
+	}
+	writeRow := func(group string, stats PipelineRunStats) error {
+		seconds := func(duration time.Duration) string {
+			return strconv.FormatFloat(duration.Seconds(), 'f', -1, 64)
+		}
+		queueLatency := []string{"", "", ""}
+		if stats.QueueLatency != nil {
+			queueLatency = []string{seconds(stats.QueueLatency.P50), seconds(stats.QueueLatency.P90), seconds(stats.QueueLatency.P99)}
+		}
+		return csvWriter.Write([]string{
+			group, stats.Name, strconv.Itoa(stats.Total), strconv.Itoa(stats.Succeeded), strconv.Itoa(stats.Failed),
+			strconv.Itoa(stats.Errored), strconv.Itoa(stats.Cancelled), strconv.Itoa(stats.Active),
+			strconv.FormatFloat(stats.SuccessRate, 'f', 4, 64),
+			seconds(stats.Duration.P50), seconds(stats.Duration.P90), seconds(stats.Duration.P99),
+			queueLatency[0], queueLatency[1], queueLatency[2],
+			strconv.Itoa(stats.LongestFailureStreak),
+		})
+	}
+	if err := writeRow("overall", analytics.Overall); err != nil {
+		return err
+	}
+	for _, stats := range analytics.Triggers {
+		if err := writeRow("trigger", stats); err != nil {
+			return err
+		}
+	}
+	for _, stats := range analytics.Workers {
+		if err := writeRow("worker", stats); err != nil {
+			return err
+		}
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// AnalyzeTektonPipelineRunsOptions : The AnalyzeTektonPipelineRuns options.
+type AnalyzeTektonPipelineRunsOptions struct {
+	// The Tekton pipeline ID.
+	PipelineID *string `json:"pipeline_id" validate:"required,ne="`
+
+	// Only analyze runs created at or after this time.
+	Since *strfmt.DateTime `json:"since,omitempty"`
+
+	// Only analyze runs created before this time.
+	Until *strfmt.DateTime `json:"until,omitempty"`
+
+	// Only analyze runs started by this trigger.
+	TriggerName *string `json:"trigger_name,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewAnalyzeTektonPipelineRunsOptions : Instantiate AnalyzeTektonPipelineRunsOptions
+func (*CdTektonPipelineV2) NewAnalyzeTektonPipelineRunsOptions(pipelineID string) *AnalyzeTektonPipelineRunsOptions {
+	return &AnalyzeTektonPipelineRunsOptions{
+		PipelineID: core.StringPtr(pipelineID),
+	}
+}
+
+// SetPipelineID : Allow user to set PipelineID
+func (_options *AnalyzeTektonPipelineRunsOptions) SetPipelineID(pipelineID string) *AnalyzeTektonPipelineRunsOptions {
+	_options.PipelineID = core.StringPtr(pipelineID)
+	return _options
+}
+
+// SetSince : Allow user to set Since
+func (_options *AnalyzeTektonPipelineRunsOptions) SetSince(since *strfmt.DateTime) *AnalyzeTektonPipelineRunsOptions {
+	_options.Since = since
+	return _options
+}
+
+// SetUntil : Allow user to set Until
+func (_options *AnalyzeTektonPipelineRunsOptions) SetUntil(until *strfmt.DateTime) *AnalyzeTektonPipelineRunsOptions {
+	_options.Until = until
+	return _options
+}
+
+// SetTriggerName : Allow user to set TriggerName
+func (_options *AnalyzeTektonPipelineRunsOptions) SetTriggerName(triggerName string) *AnalyzeTektonPipelineRunsOptions {
+	_options.TriggerName = core.StringPtr(triggerName)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *AnalyzeTektonPipelineRunsOptions) SetHeaders(param map[string]string) *AnalyzeTektonPipelineRunsOptions {
+	options.Headers = param
+	return options
+}
+
+// AnalyzeTektonPipelineRuns : Compute reliability statistics of pipeline runs
+// This pages through the runs of the pipeline created in the time window and computes their statistics per trigger
+// and per worker. The service does not report when runs started, so the queue latency is not set; to measure it, feed
+// the events of a PipelineRunWatcher to a PipelineRunAnalyzer instead.
+func (cdTektonPipeline *CdTektonPipelineV2) AnalyzeTektonPipelineRuns(analyzeTektonPipelineRunsOptions *AnalyzeTektonPipelineRunsOptions) (result *PipelineRunAnalytics, err error) {
+	result, err = cdTektonPipeline.AnalyzeTektonPipelineRunsWithContext(context.Background(), analyzeTektonPipelineRunsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// AnalyzeTektonPipelineRunsWithContext is an alternate form of the AnalyzeTektonPipelineRuns method which supports a Context parameter.
+func (cdTektonPipeline *CdTektonPipelineV2) AnalyzeTektonPipelineRunsWithContext(ctx context.Context, analyzeTektonPipelineRunsOptions *AnalyzeTektonPipelineRunsOptions) (result *PipelineRunAnalytics, err error) {
+	err = core.ValidateNotNil(analyzeTektonPipelineRunsOptions, "analyzeTektonPipelineRunsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(analyzeTektonPipelineRunsOptions, "analyzeTektonPipelineRunsOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	var since, until time.Time
+	if analyzeTektonPipelineRunsOptions.Since != nil {
+		since = time.Time(*analyzeTektonPipelineRunsOptions.Since)
+	}
+	if analyzeTektonPipelineRunsOptions.Until != nil {
+		until = time.Time(*analyzeTektonPipelineRunsOptions.Until)
+	}
+	analyzer := NewPipelineRunAnalyzer(since, until)
+
+	pager, err := cdTektonPipeline.NewTektonPipelineRunsPager(&ListTektonPipelineRunsOptions{
+		PipelineID:  analyzeTektonPipelineRunsOptions.PipelineID,
+		TriggerName: analyzeTektonPipelineRunsOptions.TriggerName,
+		Headers:     analyzeTektonPipelineRunsOptions.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "analyze-pager-error")
+		return
+	}
+	// Runs are listed from newest to oldest, so paging stops at the first page reaching before the window.
+	for pager.HasNext() {
+		var page []PipelineRun
+		page, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "analyze-list-runs-error")
+			return
+		}
+		reachedSince := false
+		for index := range page {
+			analyzer.AddRun(&page[index])
+			if createdAt := pipelineRunCreatedAt(&page[index]); !since.IsZero() && createdAt != nil && createdAt.Before(since) {
+				reachedSince = true
+			}
+		}
+		if reachedSince {
+			break
+		}
+	}
+
+	result = analyzer.Analytics()
+	return
+}
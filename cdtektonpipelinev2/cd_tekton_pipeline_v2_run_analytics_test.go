@@ -0,0 +1,161 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CdTektonPipelineV2 AnalyzeTektonPipelineRuns`, func() {
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	var store *mockRunStore
+	var testServer *httptest.Server
+	var cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+
+	BeforeEach(func() {
+		store = newMockRunStore()
+		addRun := func(id string, status string, trigger string, worker string, created time.Duration, duration time.Duration) {
+			store.add("PipelineID", mockRun{ID: id, Status: status, Trigger: trigger, Worker: worker,
+				CreatedAt: start.Add(created), UpdatedAt: start.Add(created + duration)})
+		}
+		addRun("r0", "succeeded", "push", "public", -time.Hour, time.Minute)
+		addRun("r1", "succeeded", "push", "public", 0, 10*time.Minute)
+		addRun("r2", "failed", "push", "public", time.Hour, 20*time.Minute)
+		addRun("r3", "error", "push", "public", 2*time.Hour, 30*time.Minute)
+		addRun("r4", "cancelled", "push", "public", 3*time.Hour, time.Minute)
+		addRun("r5", "failed", "push", "public", 4*time.Hour, 5*time.Minute)
+		addRun("r6", "succeeded", "nightly", "private", 5*time.Hour, 40*time.Minute)
+		addRun("r7", "running", "nightly", "private", 6*time.Hour, 2*time.Minute)
+		testServer = store.server()
+		var serviceErr error
+		cdTektonPipelineService, serviceErr = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	analyze := func() *cdtektonpipelinev2.PipelineRunAnalytics {
+		since := strfmt.DateTime(start)
+		analytics, err := cdTektonPipelineService.AnalyzeTektonPipelineRuns(
+			cdTektonPipelineService.NewAnalyzeTektonPipelineRunsOptions("PipelineID").SetSince(&since))
+		Expect(err).To(BeNil())
+		return analytics
+	}
+
+	It(`Invoke AnalyzeTektonPipelineRuns to compute statistics per trigger and worker`, func() {
+		analytics := analyze()
+		overall := analytics.Overall
+		Expect(overall.Total).To(Equal(7))
+		Expect([]int{overall.Succeeded, overall.Failed, overall.Errored, overall.Cancelled, overall.Active}).To(Equal([]int{2, 2, 1, 1, 1}))
+		Expect(overall.SuccessRate).To(BeNumerically("~", 0.4))
+		Expect(overall.Duration).To(Equal(cdtektonpipelinev2.PipelineRunDurationStats{
+			Count: 6, P50: 10 * time.Minute, P90: 40 * time.Minute, P99: 40 * time.Minute, Max: 40 * time.Minute}))
+		Expect(overall.QueueLatency).To(BeNil())
+		Expect(overall.LongestFailureStreak).To(Equal(3))
+
+		Expect(analytics.Triggers).To(HaveLen(2))
+		Expect(analytics.Triggers[0].Name).To(Equal("nightly"))
+		Expect(analytics.Triggers[0].SuccessRate).To(Equal(1.0))
+		Expect(analytics.Triggers[1].Name).To(Equal("push"))
+		Expect(analytics.Triggers[1].Total).To(Equal(5))
+		Expect(analytics.Workers).To(HaveLen(2))
+		Expect(analytics.Workers[0].Name).To(Equal("private"))
+		Expect(analytics.Workers[1].LongestFailureStreak).To(Equal(3))
+	})
+	It(`Invoke AnalyzeTektonPipelineRuns without queue latency`, func() {
+		store.setStatus("r7", "succeeded")
+		analytics := analyze()
+		Expect(analytics.Overall.Total).To(Equal(7))
+		Expect(analytics.Overall.Duration.Count).To(Equal(7))
+		Expect(analytics.Overall.QueueLatency).To(BeNil())
+		for _, stats := range analytics.Triggers {
+			Expect(stats.QueueLatency).To(BeNil())
+		}
+	})
+	It(`Invoke WriteJSON and WriteCSV to encode the statistics`, func() {
+		analytics := analyze()
+
+		var jsonOutput bytes.Buffer
+		Expect(analytics.WriteJSON(&jsonOutput)).To(Succeed())
+		var decoded map[string]interface{}
+		Expect(json.Unmarshal(jsonOutput.Bytes(), &decoded)).To(Succeed())
+		Expect(decoded["since"]).To(Equal("2026-03-01T00:00:00.000Z"))
+		Expect(decoded["overall"]).To(HaveKeyWithValue("duration", HaveKeyWithValue("p50_seconds", 600.0)))
+		Expect(decoded["overall"]).ToNot(HaveKey("queue_latency"))
+
+		var csvOutput bytes.Buffer
+		Expect(analytics.WriteCSV(&csvOutput)).To(Succeed())
+		records, err := csv.NewReader(&csvOutput).ReadAll()
+		Expect(err).To(BeNil())
+		Expect(records).To(HaveLen(6))
+		Expect(records[0][0:3]).To(Equal([]string{"group", "name", "total"}))
+		Expect(records[1][0:3]).To(Equal([]string{"overall", "", "7"}))
+		Expect(records[1][8:10]).To(Equal([]string{"0.4000", "600"}))
+		Expect(records[1][12:15]).To(Equal([]string{"", "", ""}))
+		Expect(records[3][0:3]).To(Equal([]string{"trigger", "push", "5"}))
+		Expect(records[5][0:3]).To(Equal([]string{"worker", "public", "5"}))
+	})
+	It(`Invoke PipelineRunAnalyzer with watcher events`, func() {
+		analyzer := cdtektonpipelinev2.NewPipelineRunAnalyzer(start, start.Add(time.Hour))
+		createdAt := strfmt.DateTime(start.Add(time.Minute))
+		startedAt := strfmt.DateTime(start.Add(4 * time.Minute))
+		finishedAt := strfmt.DateTime(start.Add(10 * time.Minute))
+		run := &cdtektonpipelinev2.PipelineRun{ID: core.StringPtr("run"), Status: core.StringPtr("running"), CreatedAt: &createdAt, UpdatedAt: &startedAt}
+		analyzer.ObserveEvent(cdtektonpipelinev2.PipelineRunEvent{Type: cdtektonpipelinev2.PipelineRunEventTypeStatusChangedConst, Run: run})
+		finished := *run
+		finished.Status = core.StringPtr("succeeded")
+		finished.UpdatedAt = &finishedAt
+		analyzer.ObserveEvent(cdtektonpipelinev2.PipelineRunEvent{Type: cdtektonpipelinev2.PipelineRunEventTypeStatusChangedConst, Run: &finished})
+
+		outside := strfmt.DateTime(start.Add(2 * time.Hour))
+		Expect(analyzer.AddRun(&cdtektonpipelinev2.PipelineRun{ID: core.StringPtr("late"), Status: core.StringPtr("failed"), CreatedAt: &outside})).To(BeFalse())
+
+		analytics := analyzer.Analytics()
+		Expect(analytics.Overall.Total).To(Equal(1))
+		Expect(analytics.Overall.Succeeded).To(Equal(1))
+		Expect(analytics.Overall.Duration.P50).To(Equal(9 * time.Minute))
+		Expect(analytics.Overall.QueueLatency.Count).To(Equal(1))
+		Expect(analytics.Overall.QueueLatency.P50).To(Equal(3 * time.Minute))
+
+		var csvOutput bytes.Buffer
+		Expect(analytics.WriteCSV(&csvOutput)).To(Succeed())
+		records, err := csv.NewReader(&csvOutput).ReadAll()
+		Expect(err).To(BeNil())
+		Expect(records[1][12:15]).To(Equal([]string{"180", "180", "180"}))
+	})
+	It(`Invoke AnalyzeTektonPipelineRuns with error: Operation validation and request error`, func() {
+		_, err := cdTektonPipelineService.AnalyzeTektonPipelineRuns(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.AnalyzeTektonPipelineRuns(cdTektonPipelineService.NewAnalyzeTektonPipelineRunsOptions(""))
+		Expect(err).ToNot(BeNil())
+	})
+})
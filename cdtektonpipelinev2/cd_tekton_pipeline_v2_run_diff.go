@@ -0,0 +1,401 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// PipelineRunDiffMask replaces the values of secure properties, and of the trigger headers and event parameters that
+// may hold credentials, in a PipelineRunDiff.
+const PipelineRunDiffMask = "********"
+
+// pipelineRunSensitiveNames are the substrings of the names of the trigger headers and event parameters that may hold
+// credentials, such as `Authorization`, `X-Hub-Signature-256` or `X-Gitlab-Token`.
+var pipelineRunSensitiveNames = []string{
+	"apikey", "api-key", "api_key", "authorization", "cookie", "credential", "passwd", "password", "private_key", "private-key",
+	"secret", "signature", "token",
+}
+
+// isPipelineRunSensitiveName tells whether a trigger header or event parameter may hold credentials.
+func isPipelineRunSensitiveName(name string) bool {
+	name = strings.ToLower(name)
+	return slices.ContainsFunc(pipelineRunSensitiveNames, func(sensitive string) bool {
+		return strings.Contains(name, sensitive)
+	})
+}
+
+// PipelineRunDiff : The differences between the inputs of two pipeline runs.
+type PipelineRunDiff struct {
+	// The Tekton pipeline ID of the base run.
+	BasePipelineID string `json:"base_pipeline_id"`
+
+	// The ID of the base run.
+	BaseID string `json:"base_id"`
+
+	// The Tekton pipeline ID of the target run.
+	TargetPipelineID string `json:"target_pipeline_id"`
+
+	// The ID of the target run.
+	TargetID string `json:"target_id"`
+
+	// The differences, ordered by section and then by field.
+	Changes []PipelineRunChange `json:"changes"`
+}
+
+// PipelineRunChange : A single difference between two pipeline runs.
+type PipelineRunChange struct {
+	// The changed field, such as `definition_id`, `properties.env`, `event_params.head_commit.id` or
+	// `trigger_headers.X-Github-Event`.
+	Field string `json:"field"`
+
+	// The kind of change.
+	Kind string `json:"kind"`
+
+	// The value in the base run, unset when the field was added.
+	Base *string `json:"base,omitempty"`
+
+	// The value in the target run, unset when the field was removed.
+	Target *string `json:"target,omitempty"`
+
+	// Whether the values are replaced by PipelineRunDiffMask.
+	Masked bool `json:"masked,omitempty"`
+}
+
+// Constants associated with the PipelineRunChange.Kind property.
+// The kind of change.
+const (
+	PipelineRunChangeKindAddedConst   = "added"
+	PipelineRunChangeKindChangedConst = "changed"
+	PipelineRunChangeKindRemovedConst = "removed"
+)
+
+// NewPipelineRunDiff compares the inputs of two pipeline runs: their definition, worker, trigger name, properties,
+// event parameters and trigger headers. The values are compared as they are, and then the values of secure properties
+// and of the trigger headers and event parameters whose names suggest credentials, such as `Authorization` or
+// `X-Hub-Signature`, are replaced by PipelineRunDiffMask: a rotated secret shows up as a masked change. The event
+// parameters nested in such a parameter, such as `credentials.value`, are masked as well.
+func NewPipelineRunDiff(base *PipelineRun, target *PipelineRun) *PipelineRunDiff {
+	diff := &PipelineRunDiff{
+		BasePipelineID:   core.StringNilMapper(base.PipelineID),
+		BaseID:           core.StringNilMapper(base.ID),
+		TargetPipelineID: core.StringNilMapper(target.PipelineID),
+		TargetID:         core.StringNilMapper(target.ID),
+		Changes:          []PipelineRunChange{},
+	}
+	secure := pipelineRunSecureProperties(base)
+	maps.Copy(secure, pipelineRunSecureProperties(target))
+	diff.compare("", pipelineRunInputs(base), pipelineRunInputs(target), func(string) bool { return false })
+	diff.compare("properties.", pipelineRunPropertyValues(base), pipelineRunPropertyValues(target), func(name string) bool { return secure[name] })
+	diff.compare("event_params", pipelineRunEventParamValues(base), pipelineRunEventParamValues(target), func(path string) bool {
+		return slices.ContainsFunc(strings.Split(path, "."), isPipelineRunSensitiveName)
+	})
+	diff.compare("trigger_headers.", pipelineRunHeaderValues(base), pipelineRunHeaderValues(target), func(name string) bool {
+		return name == pipelineRunRawHeaders || isPipelineRunSensitiveName(name)
+	})
+	return diff
+}
+
+// compare appends the differences between two sets of values, sorted by field. The values of the fields for which
+// masked returns true are replaced by PipelineRunDiffMask once compared.
+func (diff *PipelineRunDiff) compare(prefix string, base map[string]string, target map[string]string, masked func(string) bool) {
+	fields := slices.Sorted(maps.Keys(base))
+	for field := range target {
+		if _, ok := base[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	slices.Sort(fields)
+
+	for _, field := range fields {
+		baseValue, inBase := base[field]
+		targetValue, inTarget := target[field]
+		change := PipelineRunChange{Field: prefix + field}
+		switch {
+		case !inBase:
+			change.Kind = PipelineRunChangeKindAddedConst
+			change.Target = core.StringPtr(targetValue)
+		case !inTarget:
+			change.Kind = PipelineRunChangeKindRemovedConst
+			change.Base = core.StringPtr(baseValue)
+		case baseValue != targetValue:
+			change.Kind = PipelineRunChangeKindChangedConst
+			change.Base = core.StringPtr(baseValue)
+			change.Target = core.StringPtr(targetValue)
+		default:
+			continue
+		}
+		if masked(field) {
+			change.Masked = true
+			if change.Base != nil {
+				change.Base = core.StringPtr(PipelineRunDiffMask)
+			}
+			if change.Target != nil {
+				change.Target = core.StringPtr(PipelineRunDiffMask)
+			}
+		}
+		diff.Changes = append(diff.Changes, change)
+	}
+}
+
+// HasChanges returns true if the runs differ.
+func (diff *PipelineRunDiff) HasChanges() bool {
+	return len(diff.Changes) > 0
+}
+
+// Render writes the differences as text, one line per change, prefixed with `+` for added fields, `-` for removed
+// fields and `~` for changed fields. Masked values are not rendered.
+func (diff *PipelineRunDiff) Render(writer io.Writer) (err error) {
+	_, err = fmt.Fprintf(writer, "--- %s/%s\n+++ %s/%s\n", diff.BasePipelineID, diff.BaseID, diff.TargetPipelineID, diff.TargetID)
+	if err != nil {
+		return
+	}
+	if !diff.HasChanges() {
+		_, err = fmt.Fprintln(writer, "no differences")
+		return
+	}
+	for _, change := range diff.Changes {
+		switch {
+		case change.Masked:
+			_, err = fmt.Fprintf(writer, "%s %s: %s (masked)\n", pipelineRunChangeSymbols[change.Kind], change.Field, change.Kind)
+		case change.Kind == PipelineRunChangeKindAddedConst:
+			_, err = fmt.Fprintf(writer, "+ %s: %s\n", change.Field, strconv.Quote(*change.Target))
+		case change.Kind == PipelineRunChangeKindRemovedConst:
+			_, err = fmt.Fprintf(writer, "- %s: %s\n", change.Field, strconv.Quote(*change.Base))
+		default:
+			_, err = fmt.Fprintf(writer, "~ %s: %s -> %s\n", change.Field, strconv.Quote(*change.Base), strconv.Quote(*change.Target))
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// pipelineRunChangeSymbols are the prefixes of the rendered changes.
+var pipelineRunChangeSymbols = map[string]string{
+	PipelineRunChangeKindAddedConst:   "+",
+	PipelineRunChangeKindChangedConst: "~",
+	PipelineRunChangeKindRemovedConst: "-",
+}
+
+// String returns the text rendering of the differences.
+func (diff *PipelineRunDiff) String() string {
+	var builder strings.Builder
+	_ = diff.Render(&builder)
+	return builder.String()
+}
+
+// pipelineRunInputs returns the scalar inputs of a run.
+func pipelineRunInputs(run *PipelineRun) map[string]string {
+	inputs := map[string]string{
+		"definition_id": core.StringNilMapper(run.DefinitionID),
+		"trigger.name":  pipelineRunTriggerName(run),
+		"worker":        pipelineRunWorkerName(run),
+	}
+	if run.Worker != nil && run.Worker.ID != nil {
+		inputs["worker.id"] = *run.Worker.ID
+	}
+	return inputs
+}
+
+// pipelineRunPropertyValues returns the property values of a run.
+func pipelineRunPropertyValues(run *PipelineRun) map[string]string {
+	values := make(map[string]string)
+	for _, property := range run.Properties {
+		values[core.StringNilMapper(property.Name)] = core.StringNilMapper(property.Value)
+	}
+	return values
+}
+
+// pipelineRunSecureProperties returns the names of the secure properties of a run.
+func pipelineRunSecureProperties(run *PipelineRun) map[string]bool {
+	secure := make(map[string]bool)
+	for _, property := range run.Properties {
+		if core.StringNilMapper(property.Type) == PropertyTypeSecureConst {
+			secure[core.StringNilMapper(property.Name)] = true
+		}
+	}
+	return secure
+}
+
+// pipelineRunEventParamValues flattens the event parameters of a run into dotted paths. Event parameters that are not
+// valid JSON are compared as a whole.
+func pipelineRunEventParamValues(run *PipelineRun) map[string]string {
+	values := make(map[string]string)
+	if run.EventParamsBlob == nil || strings.TrimSpace(*run.EventParamsBlob) == "" {
+		return values
+	}
+	var decoded interface{}
+	if json.Unmarshal([]byte(*run.EventParamsBlob), &decoded) != nil {
+		values[""] = *run.EventParamsBlob
+		return values
+	}
+	flattenEventParams(values, "", decoded)
+	return values
+}
+
+// flattenEventParams records the scalar values of a decoded JSON value under their dotted path.
+func flattenEventParams(values map[string]string, path string, value interface{}) {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, child := range typed {
+			flattenEventParams(values, path+"."+key, child)
+		}
+	case []interface{}:
+		for index, child := range typed {
+			flattenEventParams(values, fmt.Sprintf("%s[%d]", path, index), child)
+		}
+	case nil:
+		values[path] = "null"
+	case string:
+		values[path] = typed
+	default:
+		encoded, _ := json.Marshal(typed)
+		values[path] = string(encoded)
+	}
+}
+
+// pipelineRunRawHeaders is the field of the trigger headers that cannot be decoded.
+const pipelineRunRawHeaders = "(raw)"
+
+// pipelineRunHeaderValues returns the trigger headers of a run, with multiple values joined by commas. Headers that
+// cannot be decoded are compared as a whole.
+func pipelineRunHeaderValues(run *PipelineRun) map[string]string {
+	values := make(map[string]string)
+	headers, err := run.DecodeTriggerHeaders()
+	if err != nil {
+		values[pipelineRunRawHeaders] = *run.TriggerHeaders
+		return values
+	}
+	for name, headerValues := range headers {
+		values[name] = strings.Join(headerValues, ", ")
+	}
+	return values
+}
+
+// ComparePipelineRunsOptions : The ComparePipelineRuns options.
+type ComparePipelineRunsOptions struct {
+	// The Tekton pipeline ID of the base run.
+	BasePipelineID *string `json:"base_pipeline_id" validate:"required,ne="`
+
+	// The ID of the base run.
+	BaseID *string `json:"base_id" validate:"required,ne="`
+
+	// The Tekton pipeline ID of the target run.
+	TargetPipelineID *string `json:"target_pipeline_id" validate:"required,ne="`
+
+	// The ID of the target run.
+	TargetID *string `json:"target_id" validate:"required,ne="`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewComparePipelineRunsOptions : Instantiate ComparePipelineRunsOptions
+func (*CdTektonPipelineV2) NewComparePipelineRunsOptions(basePipelineID string, baseID string, targetPipelineID string, targetID string) *ComparePipelineRunsOptions {
+	return &ComparePipelineRunsOptions{
+		BasePipelineID:   core.StringPtr(basePipelineID),
+		BaseID:           core.StringPtr(baseID),
+		TargetPipelineID: core.StringPtr(targetPipelineID),
+		TargetID:         core.StringPtr(targetID),
+	}
+}
+
+// SetBasePipelineID : Allow user to set BasePipelineID
+func (_options *ComparePipelineRunsOptions) SetBasePipelineID(basePipelineID string) *ComparePipelineRunsOptions {
+	_options.BasePipelineID = core.StringPtr(basePipelineID)
+	return _options
+}
+
+// SetBaseID : Allow user to set BaseID
+func (_options *ComparePipelineRunsOptions) SetBaseID(baseID string) *ComparePipelineRunsOptions {
+	_options.BaseID = core.StringPtr(baseID)
+	return _options
+}
+
+// SetTargetPipelineID : Allow user to set TargetPipelineID
+func (_options *ComparePipelineRunsOptions) SetTargetPipelineID(targetPipelineID string) *ComparePipelineRunsOptions {
+	_options.TargetPipelineID = core.StringPtr(targetPipelineID)
+	return _options
+}
+
+// SetTargetID : Allow user to set TargetID
+func (_options *ComparePipelineRunsOptions) SetTargetID(targetID string) *ComparePipelineRunsOptions {
+	_options.TargetID = core.StringPtr(targetID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ComparePipelineRunsOptions) SetHeaders(param map[string]string) *ComparePipelineRunsOptions {
+	options.Headers = param
+	return options
+}
+
+// ComparePipelineRuns : Compare the inputs of two pipeline runs
+// This loads two pipeline runs, possibly from different pipelines, and returns the differences between their inputs.
+func (cdTektonPipeline *CdTektonPipelineV2) ComparePipelineRuns(comparePipelineRunsOptions *ComparePipelineRunsOptions) (result *PipelineRunDiff, err error) {
+	result, err = cdTektonPipeline.ComparePipelineRunsWithContext(context.Background(), comparePipelineRunsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ComparePipelineRunsWithContext is an alternate form of the ComparePipelineRuns method which supports a Context parameter.
+func (cdTektonPipeline *CdTektonPipelineV2) ComparePipelineRunsWithContext(ctx context.Context, comparePipelineRunsOptions *ComparePipelineRunsOptions) (result *PipelineRunDiff, err error) {
+	err = core.ValidateNotNil(comparePipelineRunsOptions, "comparePipelineRunsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(comparePipelineRunsOptions, "comparePipelineRunsOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	base, _, err := cdTektonPipeline.GetTektonPipelineRunWithContext(ctx, &GetTektonPipelineRunOptions{
+		PipelineID: comparePipelineRunsOptions.BasePipelineID,
+		ID:         comparePipelineRunsOptions.BaseID,
+		Headers:    comparePipelineRunsOptions.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "compare-get-base-run-error")
+		return
+	}
+	target, _, err := cdTektonPipeline.GetTektonPipelineRunWithContext(ctx, &GetTektonPipelineRunOptions{
+		PipelineID: comparePipelineRunsOptions.TargetPipelineID,
+		ID:         comparePipelineRunsOptions.TargetID,
+		Headers:    comparePipelineRunsOptions.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "compare-get-target-run-error")
+		return
+	}
+
+	result = NewPipelineRunDiff(base, target)
+	return
+}
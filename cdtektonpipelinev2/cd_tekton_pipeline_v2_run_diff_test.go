@@ -0,0 +1,161 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"net/http/httptest"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CdTektonPipelineV2 PipelineRunDiff`, func() {
+	property := func(name string, value string, propertyType string) cdtektonpipelinev2.Property {
+		return cdtektonpipelinev2.Property{Name: core.StringPtr(name), Value: core.StringPtr(value), Type: core.StringPtr(propertyType)}
+	}
+
+	It(`Invoke NewPipelineRunDiff to compare run inputs`, func() {
+		base := &cdtektonpipelinev2.PipelineRun{
+			ID:              core.StringPtr("run-1"),
+			PipelineID:      core.StringPtr("pipeline-a"),
+			DefinitionID:    core.StringPtr("def-1"),
+			Worker:          &cdtektonpipelinev2.PipelineRunWorker{Name: core.StringPtr("public"), ID: core.StringPtr("public")},
+			Trigger:         &cdtektonpipelinev2.Trigger{Name: core.StringPtr("push")},
+			Properties:      []cdtektonpipelinev2.Property{property("env", "dev", "text"), property("token", "one", "secure"), property("old", "x", "text")},
+			EventParamsBlob: core.StringPtr(`{"ref": "refs/heads/main", "head_commit": {"id": "aaa"}, "labels": ["a"], "hook": {"config": {"secret": "s1"}}}`),
+			TriggerHeaders:  core.StringPtr(`{"X-GitHub-Event": "push", "Authorization": "Bearer one", "X-Hub-Signature-256": "sha256=1"}`),
+		}
+		target := &cdtektonpipelinev2.PipelineRun{
+			ID:              core.StringPtr("run-2"),
+			PipelineID:      core.StringPtr("pipeline-b"),
+			DefinitionID:    core.StringPtr("def-1"),
+			Worker:          &cdtektonpipelinev2.PipelineRunWorker{Name: core.StringPtr("private"), ID: core.StringPtr("private-id")},
+			Trigger:         &cdtektonpipelinev2.Trigger{Name: core.StringPtr("push")},
+			Properties:      []cdtektonpipelinev2.Property{property("env", "prod", "text"), property("token", "two", "secure"), property("new", "y", "text")},
+			EventParamsBlob: core.StringPtr(`{"ref": "refs/heads/main", "head_commit": {"id": "bbb"}, "labels": ["a", "b"], "hook": {"config": {"secret": "s2"}}}`),
+			TriggerHeaders:  core.StringPtr(`{"X-GitHub-Event": "push", "X-Hook-Id": ["1"], "Authorization": "Bearer two", "X-Gitlab-Token": "t"}`),
+		}
+
+		diff := cdtektonpipelinev2.NewPipelineRunDiff(base, target)
+		Expect(diff.HasChanges()).To(BeTrue())
+		fields := []string{}
+		for _, change := range diff.Changes {
+			fields = append(fields, change.Kind+" "+change.Field)
+		}
+		Expect(fields).To(Equal([]string{
+			"changed worker",
+			"changed worker.id",
+			"changed properties.env",
+			"added properties.new",
+			"removed properties.old",
+			"changed properties.token",
+			"changed event_params.head_commit.id",
+			"changed event_params.hook.config.secret",
+			"added event_params.labels[1]",
+			"changed trigger_headers.Authorization",
+			"added trigger_headers.X-Gitlab-Token",
+			"added trigger_headers.X-Hook-Id",
+			"removed trigger_headers.X-Hub-Signature-256",
+		}))
+		Expect(diff.Changes[5]).To(Equal(cdtektonpipelinev2.PipelineRunChange{
+			Field: "properties.token", Kind: "changed", Base: core.StringPtr("********"), Target: core.StringPtr("********"), Masked: true,
+		}))
+		Expect(diff.String()).To(Equal(`--- pipeline-a/run-1
++++ pipeline-b/run-2
+~ worker: "public" -> "private"
+~ worker.id: "public" -> "private-id"
+~ properties.env: "dev" -> "prod"
++ properties.new: "y"
+- properties.old: "x"
+~ properties.token: changed (masked)
+~ event_params.head_commit.id: "aaa" -> "bbb"
+~ event_params.hook.config.secret: changed (masked)
++ event_params.labels[1]: "b"
+~ trigger_headers.Authorization: changed (masked)
++ trigger_headers.X-Gitlab-Token: added (masked)
++ trigger_headers.X-Hook-Id: "1"
+- trigger_headers.X-Hub-Signature-256: removed (masked)
+`))
+		Expect(diff.String()).ToNot(ContainSubstring("Bearer"))
+
+		Expect(cdtektonpipelinev2.NewPipelineRunDiff(base, base).String()).To(HaveSuffix("no differences\n"))
+	})
+
+	It(`Invoke NewPipelineRunDiff to mask nested secrets`, func() {
+		base := &cdtektonpipelinev2.PipelineRun{
+			EventParamsBlob: core.StringPtr(`{"credentials": {"value": "one"}, "secret": {"key": "k1"}, "tokens": ["t1"], "ref": "main"}`),
+		}
+		target := &cdtektonpipelinev2.PipelineRun{
+			EventParamsBlob: core.StringPtr(`{"credentials": {"value": "two"}, "secret": {"key": "k2"}, "tokens": ["t2"], "ref": "dev"}`),
+		}
+
+		diff := cdtektonpipelinev2.NewPipelineRunDiff(base, target)
+		Expect(diff.String()).To(Equal(`--- /
++++ /
+~ event_params.credentials.value: changed (masked)
+~ event_params.ref: "main" -> "dev"
+~ event_params.secret.key: changed (masked)
+~ event_params.tokens[0]: changed (masked)
+`))
+	})
+
+	Describe(`ComparePipelineRuns(comparePipelineRunsOptions *ComparePipelineRunsOptions)`, func() {
+		var store *mockRunStore
+		var testServer *httptest.Server
+		var cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+
+		BeforeEach(func() {
+			store = newMockRunStore()
+			store.add("pipeline-a", mockRun{ID: "run-1", Status: "succeeded", Trigger: "push", Properties: map[string]interface{}{"env": "dev"}})
+			store.add("pipeline-b", mockRun{ID: "run-2", Status: "failed", Trigger: "manual", Properties: map[string]interface{}{"env": "prod"}})
+			testServer = store.server()
+			var serviceErr error
+			cdTektonPipelineService, serviceErr = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		It(`Invoke ComparePipelineRuns successfully`, func() {
+			diff, err := cdTektonPipelineService.ComparePipelineRuns(
+				cdTektonPipelineService.NewComparePipelineRunsOptions("pipeline-a", "run-1", "pipeline-b", "run-2"))
+			Expect(err).To(BeNil())
+			Expect(diff.BaseID).To(Equal("run-1"))
+			Expect(diff.TargetPipelineID).To(Equal("pipeline-b"))
+			Expect(diff.Changes).To(ConsistOf(
+				cdtektonpipelinev2.PipelineRunChange{Field: "trigger.name", Kind: "changed", Base: core.StringPtr("push"), Target: core.StringPtr("manual")},
+				cdtektonpipelinev2.PipelineRunChange{Field: "properties.env", Kind: "changed", Base: core.StringPtr("dev"), Target: core.StringPtr("prod")},
+			))
+		})
+		It(`Invoke ComparePipelineRuns with error: Operation validation and request error`, func() {
+			_, err := cdTektonPipelineService.ComparePipelineRuns(nil)
+			Expect(err).ToNot(BeNil())
+			_, err = cdTektonPipelineService.ComparePipelineRuns(
+				cdTektonPipelineService.NewComparePipelineRunsOptions("pipeline-a", "run-1", "pipeline-b", ""))
+			Expect(err).ToNot(BeNil())
+			_, err = cdTektonPipelineService.ComparePipelineRuns(
+				cdTektonPipelineService.NewComparePipelineRunsOptions("pipeline-a", "run-1", "pipeline-b", "missing"))
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
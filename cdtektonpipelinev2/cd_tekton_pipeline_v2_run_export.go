@@ -0,0 +1,260 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// PipelineRunRow : A flat representation of a pipeline run, as exported by WritePipelineRunsCSV and
+// WritePipelineRunsJSONLines.
+type PipelineRunRow struct {
+	PipelineID      string   `json:"pipeline_id"`
+	ID              string   `json:"id"`
+	Status          string   `json:"status"`
+	TriggerName     string   `json:"trigger_name"`
+	TriggerType     string   `json:"trigger_type"`
+	Worker          string   `json:"worker"`
+	DefinitionID    string   `json:"definition_id"`
+	Description     string   `json:"description"`
+	CreatedAt       string   `json:"created_at"`
+	UpdatedAt       string   `json:"updated_at"`
+	DurationSeconds *float64 `json:"duration_seconds"`
+	ErrorMessage    string   `json:"error_message"`
+	RunURL          string   `json:"run_url"`
+}
+
+// pipelineRunRowCSVHeader is the header row written by WritePipelineRunsCSV, in the order of the PipelineRunRow fields.
+var pipelineRunRowCSVHeader = []string{
+	"pipeline_id", "id", "status", "trigger_name", "trigger_type", "worker", "definition_id", "description",
+	"created_at", "updated_at", "duration_seconds", "error_message", "run_url",
+}
+
+// NewPipelineRunRow flattens a pipeline run. Times are formatted as RFC 3339 and the duration, between the creation and
+// the last update, is only set for runs in a terminal status.
+func NewPipelineRunRow(run *PipelineRun) *PipelineRunRow {
+	row := &PipelineRunRow{
+		PipelineID:   core.StringNilMapper(run.PipelineID),
+		ID:           core.StringNilMapper(run.ID),
+		Status:       core.StringNilMapper(run.Status),
+		TriggerName:  pipelineRunTriggerName(run),
+		Worker:       pipelineRunWorkerName(run),
+		DefinitionID: core.StringNilMapper(run.DefinitionID),
+		Description:  core.StringNilMapper(run.Description),
+		ErrorMessage: core.StringNilMapper(run.ErrorMessage),
+		RunURL:       core.StringNilMapper(run.RunURL),
+	}
+	if trigger, ok := run.Trigger.(*Trigger); ok && trigger != nil {
+		row.TriggerType = core.StringNilMapper(trigger.Type)
+	}
+	if run.CreatedAt != nil {
+		row.CreatedAt = time.Time(*run.CreatedAt).UTC().Format(time.RFC3339Nano)
+	}
+	if run.UpdatedAt != nil {
+		row.UpdatedAt = time.Time(*run.UpdatedAt).UTC().Format(time.RFC3339Nano)
+	}
+	if duration, ok := pipelineRunDuration(run); ok {
+		seconds := duration.Seconds()
+		row.DurationSeconds = &seconds
+	}
+	return row
+}
+
+// pipelineRunDuration returns the time between the creation and the last update of a run in a terminal status.
+func pipelineRunDuration(run *PipelineRun) (duration time.Duration, ok bool) {
+	if !run.RunStatus().IsTerminal() || run.CreatedAt == nil || run.UpdatedAt == nil {
+		return
+	}
+	duration = time.Time(*run.UpdatedAt).Sub(time.Time(*run.CreatedAt))
+	return duration, duration >= 0
+}
+
+// WritePipelineRunsCSV writes the runs as CSV, with a header row followed by one row per run.
+func WritePipelineRunsCSV(writer io.Writer, runs []PipelineRun) error {
+	csvWriter := csv.NewWriter(writer)
+	if err := csvWriter.Write(pipelineRunRowCSVHeader); err != nil {
+		return err
+	}
+	for index := range runs {
+		row := NewPipelineRunRow(&runs[index])
+		duration := ""
+		if row.DurationSeconds != nil {
+			duration = strconv.FormatFloat(*row.DurationSeconds, 'f', -1, 64)
+		}
+		err := csvWriter.Write([]string{
+			row.PipelineID, row.ID, row.Status, row.TriggerName, row.TriggerType, row.Worker, row.DefinitionID,
+			row.Description, row.CreatedAt, row.UpdatedAt, duration, row.ErrorMessage, row.RunURL,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// WritePipelineRunsJSONLines writes the runs as JSON Lines, with one JSON object per run.
+func WritePipelineRunsJSONLines(writer io.Writer, runs []PipelineRun) error {
+	encoder := json.NewEncoder(writer)
+	for index := range runs {
+		if err := encoder.Encode(NewPipelineRunRow(&runs[index])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// junitTestSuites is the root element of a JUnit XML report.
+type junitTestSuites struct {
+	XMLName    xml.Name         `xml:"testsuites"`
+	Tests      int              `xml:"tests,attr"`
+	Failures   int              `xml:"failures,attr"`
+	Errors     int              `xml:"errors,attr"`
+	Skipped    int              `xml:"skipped,attr"`
+	TestSuites []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Errors    int             `xml:"errors,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Timestamp string          `xml:"timestamp,attr,omitempty"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr,omitempty"`
+	Failure   *junitProblem `xml:"failure,omitempty"`
+	Error     *junitProblem `xml:"error,omitempty"`
+	Skipped   *junitProblem `xml:"skipped,omitempty"`
+}
+
+type junitProblem struct {
+	Message string `xml:"message,attr,omitempty"`
+	Type    string `xml:"type,attr,omitempty"`
+}
+
+// add appends a test case to the suite and updates its counters.
+func (suite *junitTestSuite) add(testCase junitTestCase) {
+	suite.TestCases = append(suite.TestCases, testCase)
+	suite.Tests++
+	switch {
+	case testCase.Failure != nil:
+		suite.Failures++
+	case testCase.Error != nil:
+		suite.Errors++
+	case testCase.Skipped != nil:
+		suite.Skipped++
+	}
+}
+
+// newJUnitRunTestCase returns the test case reporting the outcome of a run: `failed` runs are failures, `error` runs
+// are errors, and cancelled or unfinished runs are skipped.
+func newJUnitRunTestCase(run *PipelineRun, name string, className string) junitTestCase {
+	testCase := junitTestCase{Name: name, ClassName: className}
+	if duration, ok := pipelineRunDuration(run); ok {
+		testCase.Time = strconv.FormatFloat(duration.Seconds(), 'f', 3, 64)
+	}
+	status := core.StringNilMapper(run.Status)
+	switch run.RunStatus() {
+	case RunStatusSucceededConst:
+	case RunStatusFailedConst:
+		testCase.Failure = &junitProblem{Message: core.StringNilMapper(run.ErrorMessage), Type: status}
+	case RunStatusErrorConst:
+		testCase.Error = &junitProblem{Message: core.StringNilMapper(run.ErrorMessage), Type: status}
+	default:
+		testCase.Skipped = &junitProblem{Message: status}
+	}
+	return testCase
+}
+
+// WritePipelineRunsJUnit writes the runs as a JUnit XML report.
+//
+// Runs without step logs in stepLogs are reported as test cases of one test suite per pipeline. Runs with step logs,
+// keyed by run ID, get a test suite of their own with one test case per step, named after the task and step, and a
+// final `pipeline run` test case carrying the outcome of the run, since the logs do not tell which step failed. The
+// steps of a run that did not succeed are reported as skipped, with the error message of the run, so that they are not
+// reported as passed.
+func WritePipelineRunsJUnit(writer io.Writer, runs []PipelineRun, stepLogs map[string]*LogsCollection) error {
+	report := junitTestSuites{}
+	pipelineSuites := make(map[string]int)
+	for index := range runs {
+		run := &runs[index]
+		runID := core.StringNilMapper(run.ID)
+		pipelineID := core.StringNilMapper(run.PipelineID)
+		timestamp := ""
+		if run.CreatedAt != nil {
+			timestamp = time.Time(*run.CreatedAt).UTC().Format(time.RFC3339)
+		}
+
+		if logs := stepLogs[runID]; logs != nil {
+			suite := junitTestSuite{Name: pipelineID + "/" + runID, Timestamp: timestamp}
+			var stepSkipped *junitProblem
+			if run.RunStatus() != RunStatusSucceededConst {
+				stepSkipped = &junitProblem{Message: core.StringNilMapper(run.Status), Type: core.StringNilMapper(run.Status)}
+				if run.ErrorMessage != nil && *run.ErrorMessage != "" {
+					stepSkipped.Message = *run.ErrorMessage
+				}
+			}
+			for _, task := range NewPipelineRunTopology(runID, logs.Logs).Tasks {
+				for _, step := range task.Steps {
+					suite.add(junitTestCase{Name: step.Name, ClassName: task.Name, Skipped: stepSkipped})
+				}
+			}
+			suite.add(newJUnitRunTestCase(run, "pipeline run", pipelineRunTriggerName(run)))
+			report.TestSuites = append(report.TestSuites, suite)
+			continue
+		}
+
+		suiteIndex, ok := pipelineSuites[pipelineID]
+		if !ok {
+			suiteIndex = len(report.TestSuites)
+			pipelineSuites[pipelineID] = suiteIndex
+			report.TestSuites = append(report.TestSuites, junitTestSuite{Name: pipelineID, Timestamp: timestamp})
+		}
+		report.TestSuites[suiteIndex].add(newJUnitRunTestCase(run, runID, pipelineRunTriggerName(run)))
+	}
+
+	for _, suite := range report.TestSuites {
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+		report.Errors += suite.Errors
+		report.Skipped += suite.Skipped
+	}
+
+	if _, err := io.WriteString(writer, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(writer)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return err
+	}
+	_, err := io.WriteString(writer, "\n")
+	return err
+}
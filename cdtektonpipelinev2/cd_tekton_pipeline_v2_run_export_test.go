@@ -0,0 +1,144 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CdTektonPipelineV2 pipeline run exporters`, func() {
+	var runs []cdtektonpipelinev2.PipelineRun
+
+	BeforeEach(func() {
+		newRun := func(pipelineID string, id string, status string, errorMessage string) cdtektonpipelinev2.PipelineRun {
+			createdAt := strfmt.DateTime(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+			updatedAt := strfmt.DateTime(time.Date(2026, 3, 1, 12, 1, 30, 0, time.UTC))
+			run := cdtektonpipelinev2.PipelineRun{
+				ID:           core.StringPtr(id),
+				PipelineID:   core.StringPtr(pipelineID),
+				Status:       core.StringPtr(status),
+				DefinitionID: core.StringPtr("def"),
+				Worker:       &cdtektonpipelinev2.PipelineRunWorker{Name: core.StringPtr("public"), ID: core.StringPtr("public")},
+				Trigger:      &cdtektonpipelinev2.Trigger{Name: core.StringPtr("push"), Type: core.StringPtr("scm")},
+				CreatedAt:    &createdAt,
+				UpdatedAt:    &updatedAt,
+				RunURL:       core.StringPtr("https://example.com/" + id),
+			}
+			if errorMessage != "" {
+				run.ErrorMessage = core.StringPtr(errorMessage)
+			}
+			return run
+		}
+		runs = []cdtektonpipelinev2.PipelineRun{
+			newRun("pipeline-a", "run-1", "succeeded", ""),
+			newRun("pipeline-a", "run-2", "failed", "step unit-test failed"),
+			newRun("pipeline-b", "run-3", "running", ""),
+			newRun("pipeline-b", "run-4", "error", "worker lost"),
+		}
+	})
+
+	It(`Invoke WritePipelineRunsCSV successfully`, func() {
+		var output bytes.Buffer
+		Expect(cdtektonpipelinev2.WritePipelineRunsCSV(&output, runs)).To(Succeed())
+		records, err := csv.NewReader(&output).ReadAll()
+		Expect(err).To(BeNil())
+		Expect(records).To(HaveLen(5))
+		Expect(records[0][0:3]).To(Equal([]string{"pipeline_id", "id", "status"}))
+		Expect(records[2]).To(Equal([]string{"pipeline-a", "run-2", "failed", "push", "scm", "public", "def", "",
+			"2026-03-01T12:00:00Z", "2026-03-01T12:01:30Z", "90", "step unit-test failed", "https://example.com/run-2"}))
+		Expect(records[3][10]).To(BeEmpty())
+	})
+	It(`Invoke WritePipelineRunsJSONLines successfully`, func() {
+		var output bytes.Buffer
+		Expect(cdtektonpipelinev2.WritePipelineRunsJSONLines(&output, runs)).To(Succeed())
+		lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+		Expect(lines).To(HaveLen(4))
+		var row map[string]interface{}
+		Expect(json.Unmarshal([]byte(lines[1]), &row)).To(Succeed())
+		Expect(row).To(HaveKeyWithValue("id", "run-2"))
+		Expect(row).To(HaveKeyWithValue("duration_seconds", 90.0))
+		Expect(json.Unmarshal([]byte(lines[2]), &row)).To(Succeed())
+		Expect(row).To(HaveKeyWithValue("duration_seconds", BeNil()))
+	})
+	It(`Invoke WritePipelineRunsJUnit with one test case per run`, func() {
+		var output bytes.Buffer
+		Expect(cdtektonpipelinev2.WritePipelineRunsJUnit(&output, runs, nil)).To(Succeed())
+		Expect(output.String()).To(HavePrefix(xml.Header))
+
+		var report struct {
+			Tests      int `xml:"tests,attr"`
+			Failures   int `xml:"failures,attr"`
+			Errors     int `xml:"errors,attr"`
+			Skipped    int `xml:"skipped,attr"`
+			TestSuites []struct {
+				Name      string `xml:"name,attr"`
+				TestCases []struct {
+					Name    string `xml:"name,attr"`
+					Time    string `xml:"time,attr"`
+					Failure *struct {
+						Message string `xml:"message,attr"`
+					} `xml:"failure"`
+				} `xml:"testcase"`
+			} `xml:"testsuite"`
+		}
+		Expect(xml.Unmarshal(output.Bytes(), &report)).To(Succeed())
+		Expect([]int{report.Tests, report.Failures, report.Errors, report.Skipped}).To(Equal([]int{4, 1, 1, 1}))
+		Expect(report.TestSuites).To(HaveLen(2))
+		Expect(report.TestSuites[0].Name).To(Equal("pipeline-a"))
+		Expect(report.TestSuites[0].TestCases[1].Name).To(Equal("run-2"))
+		Expect(report.TestSuites[0].TestCases[1].Time).To(Equal("90.000"))
+		Expect(report.TestSuites[0].TestCases[1].Failure.Message).To(Equal("step unit-test failed"))
+	})
+	It(`Invoke WritePipelineRunsJUnit with one test case per step`, func() {
+		logs := &cdtektonpipelinev2.LogsCollection{Logs: []cdtektonpipelinev2.Log{
+			{ID: core.StringPtr("a"), Name: core.StringPtr("run-1-build-pod/step-compile")},
+			{ID: core.StringPtr("b"), Name: core.StringPtr("run-1-build-pod/step-unit-test")},
+		}}
+		var output bytes.Buffer
+		Expect(cdtektonpipelinev2.WritePipelineRunsJUnit(&output, runs[:2], map[string]*cdtektonpipelinev2.LogsCollection{"run-1": logs})).To(Succeed())
+		Expect(output.String()).To(ContainSubstring(`<testsuite name="pipeline-a/run-1" tests="3" failures="0" errors="0" skipped="0" timestamp="2026-03-01T12:00:00Z">`))
+		Expect(output.String()).To(ContainSubstring(`<testcase name="unit-test" classname="build"></testcase>`))
+		Expect(output.String()).To(ContainSubstring(`<testcase name="pipeline run" classname="push" time="90.000"></testcase>`))
+		Expect(output.String()).To(ContainSubstring(`<testsuite name="pipeline-a" tests="1"`))
+	})
+	It(`Invoke WritePipelineRunsJUnit with one test case per step for failed runs`, func() {
+		logs := &cdtektonpipelinev2.LogsCollection{Logs: []cdtektonpipelinev2.Log{
+			{ID: core.StringPtr("a"), Name: core.StringPtr("run-2-build-pod/step-compile")},
+			{ID: core.StringPtr("b"), Name: core.StringPtr("run-2-build-pod/step-unit-test")},
+		}}
+		var output bytes.Buffer
+		Expect(cdtektonpipelinev2.WritePipelineRunsJUnit(&output, runs[1:2], map[string]*cdtektonpipelinev2.LogsCollection{"run-2": logs})).To(Succeed())
+		Expect(output.String()).To(ContainSubstring(`<testsuites tests="3" failures="1" errors="0" skipped="2">`))
+		Expect(output.String()).To(ContainSubstring(`<testsuite name="pipeline-a/run-2" tests="3" failures="1" errors="0" skipped="2" timestamp="2026-03-01T12:00:00Z">`))
+		Expect(output.String()).To(ContainSubstring(`<testcase name="unit-test" classname="build">
+      <skipped message="step unit-test failed" type="failed"></skipped>
+    </testcase>`))
+		Expect(output.String()).To(ContainSubstring(`<testcase name="pipeline run" classname="push" time="90.000">
+      <failure message="step unit-test failed" type="failed"></failure>`))
+	})
+})
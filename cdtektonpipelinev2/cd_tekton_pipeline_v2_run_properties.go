@@ -0,0 +1,288 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Constants associated with the PipelineRunProperty.Source property.
+const (
+	PipelineRunPropertySourcePipelineConst = "pipeline"
+	PipelineRunPropertySourceRunConst      = "run"
+	PipelineRunPropertySourceTriggerConst  = "trigger"
+)
+
+// PipelineRunProperties : The effective properties of a pipeline run, resolved by ResolvePipelineRunProperties.
+type PipelineRunProperties struct {
+	// The properties, sorted by name.
+	Properties []PipelineRunProperty
+}
+
+// PipelineRunProperty : An effective property of a pipeline run.
+type PipelineRunProperty struct {
+	Name string
+
+	// The property type. Values passed in the run request that do not override a property are of type `text`, or
+	// `secure` when passed as secure properties.
+	Type string
+
+	Value string
+
+	Enum []string
+
+	Locked bool
+
+	Path string
+
+	// Where the value comes from, one of the PipelineRunPropertySource*Const constants.
+	Source string
+
+	// The sources whose values were overridden, from the pipeline to the trigger.
+	Overrides []string
+}
+
+// Get returns the property with the specified name, or nil if the run has no such property.
+func (properties *PipelineRunProperties) Get(name string) *PipelineRunProperty {
+	for index := range properties.Properties {
+		if properties.Properties[index].Name == name {
+			return &properties.Properties[index]
+		}
+	}
+	return nil
+}
+
+// String renders the properties for review, one per line with their source. Secure values are masked.
+func (properties *PipelineRunProperties) String() string {
+	var builder strings.Builder
+	for _, property := range properties.Properties {
+		value := property.Value
+		if property.Type == PropertyTypeSecureConst {
+			value = "********"
+		}
+		fmt.Fprintf(&builder, "%s=%s (%s from %s", property.Name, value, property.Type, property.Source)
+		if len(property.Overrides) > 0 {
+			fmt.Fprintf(&builder, ", overrides %s", strings.Join(property.Overrides, ", "))
+		}
+		builder.WriteString(")\n")
+	}
+	return builder.String()
+}
+
+// ResolvePipelineRunProperties computes the effective properties of the run that createTektonPipelineRunOptions would
+// create: the pipeline properties, overridden by the properties of the trigger, overridden by the text and secure
+// properties of the request. The request properties can be passed with TriggerProperties and SecureTriggerProperties,
+// or with the Properties and SecureProperties of Trigger.
+//
+// No HTTP call is made: the request is checked against the specified properties, and rejected when it
+//   - overrides a locked property;
+//   - passes a text value for a secure property;
+//   - passes a value of a single_select property that is not one of its enum values;
+//   - passes a value that is not a string, or passes a property both as text and as secure.
+//
+// All the problems are reported in a single error.
+func ResolvePipelineRunProperties(pipelineProperties []Property, triggerProperties []TriggerProperty, createTektonPipelineRunOptions *CreateTektonPipelineRunOptions) (result *PipelineRunProperties, err error) {
+	err = core.ValidateNotNil(createTektonPipelineRunOptions, "createTektonPipelineRunOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+
+	resolved := make(map[string]*PipelineRunProperty)
+	for _, property := range pipelineProperties {
+		name := core.StringNilMapper(property.Name)
+		resolved[name] = &PipelineRunProperty{
+			Name:   name,
+			Type:   core.StringNilMapper(property.Type),
+			Value:  core.StringNilMapper(property.Value),
+			Enum:   property.Enum,
+			Locked: property.Locked != nil && *property.Locked,
+			Path:   core.StringNilMapper(property.Path),
+			Source: PipelineRunPropertySourcePipelineConst,
+		}
+	}
+	for _, property := range triggerProperties {
+		name := core.StringNilMapper(property.Name)
+		var overrides []string
+		if existing := resolved[name]; existing != nil {
+			overrides = append(slices.Clone(existing.Overrides), existing.Source)
+		}
+		resolved[name] = &PipelineRunProperty{
+			Name:      name,
+			Type:      core.StringNilMapper(property.Type),
+			Value:     core.StringNilMapper(property.Value),
+			Enum:      property.Enum,
+			Locked:    property.Locked != nil && *property.Locked,
+			Path:      core.StringNilMapper(property.Path),
+			Source:    PipelineRunPropertySourceTriggerConst,
+			Overrides: overrides,
+		}
+	}
+
+	textValues, secureValues := pipelineRunRequestProperties(createTektonPipelineRunOptions)
+	var problems []string
+	for _, name := range slices.Sorted(maps.Keys(textValues)) {
+		if _, found := secureValues[name]; found {
+			problems = append(problems, fmt.Sprintf("property '%s' is passed both as text and as secure", name))
+		}
+	}
+	for _, secure := range []bool{false, true} {
+		values := textValues
+		if secure {
+			values = secureValues
+		}
+		for _, name := range slices.Sorted(maps.Keys(values)) {
+			value, ok := values[name].(string)
+			if !ok {
+				problems = append(problems, fmt.Sprintf("the value of property '%s' is not a string", name))
+				continue
+			}
+			existing := resolved[name]
+			property := &PipelineRunProperty{Name: name, Type: PropertyTypeTextConst, Value: value, Source: PipelineRunPropertySourceRunConst}
+			if secure {
+				property.Type = PropertyTypeSecureConst
+			}
+			if existing != nil {
+				switch {
+				case existing.Locked:
+					problems = append(problems, fmt.Sprintf("property '%s' is locked and cannot be overridden", name))
+					continue
+				case existing.Type == PropertyTypeSecureConst && !secure:
+					problems = append(problems, fmt.Sprintf("property '%s' is secure and cannot be passed as text", name))
+					continue
+				case existing.Type == PropertyTypeSingleSelectConst && !slices.Contains(existing.Enum, value):
+					problems = append(problems, fmt.Sprintf("value '%s' of property '%s' is not one of: %s", value, name, strings.Join(existing.Enum, ", ")))
+					continue
+				case existing.Type == PropertyTypeSingleSelectConst && !secure:
+					property.Type = PropertyTypeSingleSelectConst
+					property.Enum = existing.Enum
+				}
+				property.Overrides = append(slices.Clone(existing.Overrides), existing.Source)
+			}
+			resolved[name] = property
+		}
+	}
+	if len(problems) > 0 {
+		err = core.SDKErrorf(nil, "invalid properties for the pipeline run: "+strings.Join(problems, "; "), "invalid-run-properties", common.GetComponentInfo())
+		return
+	}
+
+	result = &PipelineRunProperties{Properties: []PipelineRunProperty{}}
+	for _, name := range slices.Sorted(maps.Keys(resolved)) {
+		result.Properties = append(result.Properties, *resolved[name])
+	}
+	return
+}
+
+// pipelineRunRequestProperties returns the text and secure properties of a run request.
+func pipelineRunRequestProperties(createOptions *CreateTektonPipelineRunOptions) (textValues map[string]interface{}, secureValues map[string]interface{}) {
+	textValues = maps.Clone(createOptions.TriggerProperties)
+	secureValues = maps.Clone(createOptions.SecureTriggerProperties)
+	if createOptions.Trigger != nil {
+		if textValues == nil {
+			textValues = make(map[string]interface{})
+		}
+		maps.Copy(textValues, createOptions.Trigger.Properties)
+		if secureValues == nil {
+			secureValues = make(map[string]interface{})
+		}
+		maps.Copy(secureValues, createOptions.Trigger.SecureProperties)
+	}
+	return
+}
+
+// PreflightTektonPipelineRun : Check the properties of a pipeline run request
+// This reads the properties of the pipeline and of the trigger named by the request, and resolves the effective
+// properties of the run with ResolvePipelineRunProperties, without creating the run. A request that the service would
+// reject because of its properties is rejected with an error listing the problems.
+func (cdTektonPipeline *CdTektonPipelineV2) PreflightTektonPipelineRun(createTektonPipelineRunOptions *CreateTektonPipelineRunOptions) (result *PipelineRunProperties, err error) {
+	result, err = cdTektonPipeline.PreflightTektonPipelineRunWithContext(context.Background(), createTektonPipelineRunOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PreflightTektonPipelineRunWithContext is an alternate form of the PreflightTektonPipelineRun method which supports a Context parameter.
+func (cdTektonPipeline *CdTektonPipelineV2) PreflightTektonPipelineRunWithContext(ctx context.Context, createTektonPipelineRunOptions *CreateTektonPipelineRunOptions) (result *PipelineRunProperties, err error) {
+	err = core.ValidateNotNil(createTektonPipelineRunOptions, "createTektonPipelineRunOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(createTektonPipelineRunOptions, "createTektonPipelineRunOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	properties, _, err := cdTektonPipeline.ListTektonPipelinePropertiesWithContext(ctx, &ListTektonPipelinePropertiesOptions{
+		PipelineID: createTektonPipelineRunOptions.PipelineID,
+		Headers:    createTektonPipelineRunOptions.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "preflight-list-properties-error")
+		return
+	}
+
+	var triggerProperties []TriggerProperty
+	triggerName := core.StringNilMapper(createTektonPipelineRunOptions.TriggerName)
+	if triggerName == "" && createTektonPipelineRunOptions.Trigger != nil {
+		triggerName = core.StringNilMapper(createTektonPipelineRunOptions.Trigger.Name)
+	}
+	if triggerName != "" {
+		var triggers *TriggersCollection
+		triggers, _, err = cdTektonPipeline.ListTektonPipelineTriggersWithContext(ctx, &ListTektonPipelineTriggersOptions{
+			PipelineID: createTektonPipelineRunOptions.PipelineID,
+			Name:       core.StringPtr(triggerName),
+			Headers:    createTektonPipelineRunOptions.Headers,
+		})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "preflight-list-triggers-error")
+			return
+		}
+		var triggerID *string
+		for _, triggerIntf := range triggers.Triggers {
+			if trigger, ok := triggerIntf.(*Trigger); ok && core.StringNilMapper(trigger.Name) == triggerName {
+				triggerID = trigger.ID
+				break
+			}
+		}
+		if triggerID == nil {
+			err = core.SDKErrorf(nil, fmt.Sprintf("trigger '%s' not found in pipeline '%s'", triggerName, *createTektonPipelineRunOptions.PipelineID), "preflight-trigger-not-found", common.GetComponentInfo())
+			return
+		}
+		var collection *TriggerPropertiesCollection
+		collection, _, err = cdTektonPipeline.ListTektonPipelineTriggerPropertiesWithContext(ctx, &ListTektonPipelineTriggerPropertiesOptions{
+			PipelineID: createTektonPipelineRunOptions.PipelineID,
+			TriggerID:  triggerID,
+			Headers:    createTektonPipelineRunOptions.Headers,
+		})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "preflight-list-trigger-properties-error")
+			return
+		}
+		triggerProperties = collection.Properties
+	}
+
+	return ResolvePipelineRunProperties(properties.Properties, triggerProperties, createTektonPipelineRunOptions)
+}
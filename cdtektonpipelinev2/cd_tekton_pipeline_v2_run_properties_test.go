@@ -0,0 +1,142 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"net/http/httptest"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CdTektonPipelineV2 pipeline run properties`, func() {
+	pipelineProperties := []cdtektonpipelinev2.Property{
+		{Name: core.StringPtr("region"), Type: core.StringPtr("text"), Value: core.StringPtr("us-south")},
+		{Name: core.StringPtr("env"), Type: core.StringPtr("single_select"), Value: core.StringPtr("dev"), Enum: []string{"dev", "prod"}},
+		{Name: core.StringPtr("api-key"), Type: core.StringPtr("secure"), Value: core.StringPtr("hash")},
+		{Name: core.StringPtr("cluster"), Type: core.StringPtr("text"), Value: core.StringPtr("main"), Locked: core.BoolPtr(true)},
+		{Name: core.StringPtr("repo"), Type: core.StringPtr("integration"), Value: core.StringPtr("tool-1"), Path: core.StringPtr("parameters.repo_url")},
+	}
+	triggerProperties := []cdtektonpipelinev2.TriggerProperty{
+		{Name: core.StringPtr("region"), Type: core.StringPtr("text"), Value: core.StringPtr("eu-de")},
+		{Name: core.StringPtr("mode"), Type: core.StringPtr("text"), Value: core.StringPtr("full"), Locked: core.BoolPtr(true)},
+	}
+	newCreateOptions := func() *cdtektonpipelinev2.CreateTektonPipelineRunOptions {
+		return &cdtektonpipelinev2.CreateTektonPipelineRunOptions{
+			PipelineID:  core.StringPtr("PipelineID"),
+			TriggerName: core.StringPtr("manual"),
+		}
+	}
+
+	It(`Invoke ResolvePipelineRunProperties successfully`, func() {
+		createOptions := newCreateOptions().
+			SetTriggerProperties(map[string]interface{}{"region": "us-east", "env": "prod", "extra": "1", "repo": "https://github.com/org/fork"}).
+			SetSecureTriggerProperties(map[string]interface{}{"api-key": "key"})
+		result, err := cdtektonpipelinev2.ResolvePipelineRunProperties(pipelineProperties, triggerProperties, createOptions)
+		Expect(err).To(BeNil())
+		Expect(result.String()).To(Equal(`api-key=******** (secure from run, overrides pipeline)
+cluster=main (text from pipeline)
+env=prod (single_select from run, overrides pipeline)
+extra=1 (text from run)
+mode=full (text from trigger)
+region=us-east (text from run, overrides pipeline, trigger)
+repo=https://github.com/org/fork (text from run, overrides pipeline)
+`))
+		Expect(*result.Get("env")).To(Equal(cdtektonpipelinev2.PipelineRunProperty{
+			Name: "env", Type: "single_select", Value: "prod", Enum: []string{"dev", "prod"}, Source: "run", Overrides: []string{"pipeline"},
+		}))
+		Expect(result.Get("api-key").Value).To(Equal("key"))
+		Expect(result.Get("mode").Locked).To(BeTrue())
+		Expect(result.Get("missing")).To(BeNil())
+
+		result, err = cdtektonpipelinev2.ResolvePipelineRunProperties(pipelineProperties, nil, newCreateOptions().
+			SetTrigger(&cdtektonpipelinev2.PipelineRunTrigger{Name: core.StringPtr("manual"), Properties: map[string]interface{}{"region": "jp-tok"}}))
+		Expect(err).To(BeNil())
+		Expect(result.Get("region").Value).To(Equal("jp-tok"))
+		Expect(result.Get("repo").Path).To(Equal("parameters.repo_url"))
+	})
+	It(`Invoke ResolvePipelineRunProperties with error: Invalid run properties`, func() {
+		createOptions := newCreateOptions().
+			SetTriggerProperties(map[string]interface{}{"cluster": "other", "mode": "quick", "api-key": "plain", "env": "staging", "count": 3, "twice": "a"}).
+			SetSecureTriggerProperties(map[string]interface{}{"twice": "b"})
+		_, err := cdtektonpipelinev2.ResolvePipelineRunProperties(pipelineProperties, triggerProperties, createOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(Equal("invalid properties for the pipeline run: " +
+			"property 'twice' is passed both as text and as secure; " +
+			"property 'api-key' is secure and cannot be passed as text; " +
+			"property 'cluster' is locked and cannot be overridden; " +
+			"the value of property 'count' is not a string; " +
+			"value 'staging' of property 'env' is not one of: dev, prod; " +
+			"property 'mode' is locked and cannot be overridden"))
+
+		_, err = cdtektonpipelinev2.ResolvePipelineRunProperties(pipelineProperties, nil, nil)
+		Expect(err).ToNot(BeNil())
+	})
+
+	Describe(`PreflightTektonPipelineRun`, func() {
+		var config *mockPipelineConfig
+		var testServer *httptest.Server
+		var cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+
+		BeforeEach(func() {
+			config = newMockPipelineConfig("PipelineID")
+			config.properties = []map[string]interface{}{
+				{"name": "region", "type": "text", "value": "us-south"},
+				{"name": "cluster", "type": "text", "value": "main", "locked": true},
+			}
+			config.triggers = []map[string]interface{}{{"id": "trigger-1", "name": "manual", "type": "manual", "event_listener": "listener"}}
+			config.triggerProperties["trigger-1"] = []map[string]interface{}{{"name": "region", "type": "text", "value": "eu-de"}}
+			testServer = config.server()
+			var serviceErr error
+			cdTektonPipelineService, serviceErr = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		It(`Invoke PreflightTektonPipelineRun successfully`, func() {
+			result, err := cdTektonPipelineService.PreflightTektonPipelineRun(newCreateOptions())
+			Expect(err).To(BeNil())
+			Expect(result.String()).To(Equal("cluster=main (text from pipeline)\nregion=eu-de (text from trigger, overrides pipeline)\n"))
+			Expect(config.requestLog()).To(Equal([]string{
+				"GET /tekton_pipelines/PipelineID/properties",
+				"GET /tekton_pipelines/PipelineID/triggers",
+				"GET /tekton_pipelines/PipelineID/triggers/trigger-1/properties",
+			}))
+		})
+		It(`Invoke PreflightTektonPipelineRun with error: Operation validation and request error`, func() {
+			_, err := cdTektonPipelineService.PreflightTektonPipelineRun(newCreateOptions().SetTriggerProperties(map[string]interface{}{"cluster": "other"}))
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("property 'cluster' is locked"))
+			Expect(config.requestLog()).ToNot(ContainElement("POST /tekton_pipelines/PipelineID/pipeline_runs"))
+
+			_, err = cdTektonPipelineService.PreflightTektonPipelineRun(newCreateOptions().SetTriggerName("missing"))
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("trigger 'missing' not found"))
+			_, err = cdTektonPipelineService.PreflightTektonPipelineRun(nil)
+			Expect(err).ToNot(BeNil())
+			_, err = cdTektonPipelineService.PreflightTektonPipelineRun(&cdtektonpipelinev2.CreateTektonPipelineRunOptions{})
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
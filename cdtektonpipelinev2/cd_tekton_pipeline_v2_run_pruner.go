@@ -0,0 +1,356 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// PipelineRunRetentionPolicy : A declarative retention policy applied by PruneTektonPipelineRuns.
+//
+// A run is deleted when at least one `delete` rule matches it and no `keep` rule does. Runs that are not in a
+// terminal status are never deleted, whatever the rules.
+type PipelineRunRetentionPolicy struct {
+	// The rules of the policy.
+	Rules []PipelineRunRetentionRule `json:"rules" validate:"required,min=1,dive"`
+}
+
+// PipelineRunRetentionRule : A rule of a PipelineRunRetentionPolicy. All the conditions set on a rule must hold for it to
+// match a run.
+type PipelineRunRetentionRule struct {
+	// Whether matching runs are kept or deleted.
+	Action *string `json:"action" validate:"required,oneof=keep delete"`
+
+	// Only match runs in one of these statuses. Matches every status when empty.
+	Statuses []string `json:"statuses,omitempty"`
+
+	// Only match runs started by one of these triggers. Matches every trigger when empty.
+	TriggerNames []string `json:"trigger_names,omitempty"`
+
+	// Only match runs created more than this long ago. Encoded in JSON as a duration string such as "720h".
+	OlderThan time.Duration `json:"older_than,omitempty" validate:"gte=0"`
+
+	// Only match runs created less than this long ago. Encoded in JSON as a duration string such as "720h".
+	NewerThan time.Duration `json:"newer_than,omitempty" validate:"gte=0"`
+
+	// Only match the newest N runs of each trigger that satisfy the other conditions.
+	Last int `json:"last,omitempty" validate:"gte=0"`
+}
+
+// Constants associated with the PipelineRunRetentionRule.Action property.
+// Whether matching runs are kept or deleted.
+const (
+	PipelineRunRetentionRuleActionDeleteConst = "delete"
+	PipelineRunRetentionRuleActionKeepConst   = "keep"
+)
+
+// pipelineRunRetentionRuleJSON is the JSON form of a PipelineRunRetentionRule, with its durations as strings.
+type pipelineRunRetentionRuleJSON struct {
+	*pipelineRunRetentionRuleAlias
+	OlderThan json.RawMessage `json:"older_than,omitempty"`
+	NewerThan json.RawMessage `json:"newer_than,omitempty"`
+}
+
+type pipelineRunRetentionRuleAlias PipelineRunRetentionRule
+
+// MarshalJSON encodes the durations as strings such as "720h".
+func (rule PipelineRunRetentionRule) MarshalJSON() ([]byte, error) {
+	encoded := pipelineRunRetentionRuleJSON{pipelineRunRetentionRuleAlias: (*pipelineRunRetentionRuleAlias)(&rule)}
+	if rule.OlderThan != 0 {
+		encoded.OlderThan, _ = json.Marshal(rule.OlderThan.String())
+	}
+	if rule.NewerThan != 0 {
+		encoded.NewerThan, _ = json.Marshal(rule.NewerThan.String())
+	}
+	return json.Marshal(encoded)
+}
+
+// UnmarshalJSON decodes the durations from strings such as "720h", or from numbers of nanoseconds.
+func (rule *PipelineRunRetentionRule) UnmarshalJSON(data []byte) (err error) {
+	decoded := pipelineRunRetentionRuleJSON{pipelineRunRetentionRuleAlias: (*pipelineRunRetentionRuleAlias)(rule)}
+	err = json.Unmarshal(data, &decoded)
+	if err != nil {
+		return
+	}
+	rule.OlderThan, err = unmarshalRetentionDuration(decoded.OlderThan)
+	if err != nil {
+		return
+	}
+	rule.NewerThan, err = unmarshalRetentionDuration(decoded.NewerThan)
+	return
+}
+
+// unmarshalRetentionDuration decodes a duration string or a number of nanoseconds.
+func unmarshalRetentionDuration(data json.RawMessage) (time.Duration, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return 0, nil
+	}
+	var text string
+	if json.Unmarshal(data, &text) == nil {
+		return time.ParseDuration(text)
+	}
+	var nanoseconds int64
+	err := json.Unmarshal(data, &nanoseconds)
+	return time.Duration(nanoseconds), err
+}
+
+// NewPipelineRunRetentionRule : Instantiate PipelineRunRetentionRule (Generic Model Constructor)
+func (*CdTektonPipelineV2) NewPipelineRunRetentionRule(action string) (_model *PipelineRunRetentionRule, err error) {
+	_model = &PipelineRunRetentionRule{
+		Action: core.StringPtr(action),
+	}
+	err = core.ValidateStruct(_model, "required parameters")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-missing-required", common.GetComponentInfo())
+	}
+	return
+}
+
+// PruneTektonPipelineRunsOptions : The PruneTektonPipelineRuns options.
+type PruneTektonPipelineRunsOptions struct {
+	// The Tekton pipeline ID.
+	PipelineID *string `json:"pipeline_id" validate:"required,ne="`
+
+	// The retention policy to apply.
+	Policy *PipelineRunRetentionPolicy `json:"policy" validate:"required"`
+
+	// When true, the runs to delete are reported without being deleted.
+	DryRun bool `json:"dry_run,omitempty"`
+
+	// Minimum delay between two deletions. Zero deletes runs as fast as the service answers.
+	DeleteInterval time.Duration
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewPruneTektonPipelineRunsOptions : Instantiate PruneTektonPipelineRunsOptions
+func (*CdTektonPipelineV2) NewPruneTektonPipelineRunsOptions(pipelineID string, policy *PipelineRunRetentionPolicy) *PruneTektonPipelineRunsOptions {
+	return &PruneTektonPipelineRunsOptions{
+		PipelineID: core.StringPtr(pipelineID),
+		Policy:     policy,
+	}
+}
+
+// SetPipelineID : Allow user to set PipelineID
+func (_options *PruneTektonPipelineRunsOptions) SetPipelineID(pipelineID string) *PruneTektonPipelineRunsOptions {
+	_options.PipelineID = core.StringPtr(pipelineID)
+	return _options
+}
+
+// SetPolicy : Allow user to set Policy
+func (_options *PruneTektonPipelineRunsOptions) SetPolicy(policy *PipelineRunRetentionPolicy) *PruneTektonPipelineRunsOptions {
+	_options.Policy = policy
+	return _options
+}
+
+// SetDryRun : Allow user to set DryRun
+func (_options *PruneTektonPipelineRunsOptions) SetDryRun(dryRun bool) *PruneTektonPipelineRunsOptions {
+	_options.DryRun = dryRun
+	return _options
+}
+
+// SetDeleteInterval : Allow user to set DeleteInterval
+func (_options *PruneTektonPipelineRunsOptions) SetDeleteInterval(deleteInterval time.Duration) *PruneTektonPipelineRunsOptions {
+	_options.DeleteInterval = deleteInterval
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *PruneTektonPipelineRunsOptions) SetHeaders(param map[string]string) *PruneTektonPipelineRunsOptions {
+	options.Headers = param
+	return options
+}
+
+// PipelineRunPruneReport : The result of PruneTektonPipelineRuns.
+type PipelineRunPruneReport struct {
+	// Whether the runs were only listed.
+	DryRun bool `json:"dry_run"`
+
+	// Number of runs kept, including the runs that are not in a terminal status.
+	Kept int `json:"kept"`
+
+	// One result per run selected for deletion, from newest to oldest.
+	Results []PipelineRunPruneResult `json:"results"`
+}
+
+// PipelineRunPruneResult : The result of pruning a single pipeline run.
+type PipelineRunPruneResult struct {
+	// The pipeline run selected for deletion.
+	Run *PipelineRun `json:"run"`
+
+	// Whether the run was deleted. Always false in dry-run mode.
+	Deleted bool `json:"deleted"`
+
+	// The error returned when deleting the run, if any.
+	Error error `json:"-"`
+}
+
+// Failed returns the results of the runs that could not be deleted.
+func (report *PipelineRunPruneReport) Failed() (failed []PipelineRunPruneResult) {
+	for _, result := range report.Results {
+		if result.Error != nil {
+			failed = append(failed, result)
+		}
+	}
+	return
+}
+
+// PruneTektonPipelineRuns : Delete pipeline runs according to a retention policy
+// This pages through all the runs of the pipeline, selects the terminal runs to delete according to the retention
+// policy and deletes them one at a time. Failures to delete individual runs are reported in the result, and do not
+// stop the pruning.
+func (cdTektonPipeline *CdTektonPipelineV2) PruneTektonPipelineRuns(pruneTektonPipelineRunsOptions *PruneTektonPipelineRunsOptions) (result *PipelineRunPruneReport, err error) {
+	result, err = cdTektonPipeline.PruneTektonPipelineRunsWithContext(context.Background(), pruneTektonPipelineRunsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PruneTektonPipelineRunsWithContext is an alternate form of the PruneTektonPipelineRuns method which supports a Context parameter.
+// Cancelling the context stops the pruning and returns the report built so far together with the cancellation error.
+func (cdTektonPipeline *CdTektonPipelineV2) PruneTektonPipelineRunsWithContext(ctx context.Context, pruneTektonPipelineRunsOptions *PruneTektonPipelineRunsOptions) (result *PipelineRunPruneReport, err error) {
+	err = core.ValidateNotNil(pruneTektonPipelineRunsOptions, "pruneTektonPipelineRunsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(pruneTektonPipelineRunsOptions, "pruneTektonPipelineRunsOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	pager, err := cdTektonPipeline.NewTektonPipelineRunsPager(&ListTektonPipelineRunsOptions{
+		PipelineID: pruneTektonPipelineRunsOptions.PipelineID,
+		Headers:    pruneTektonPipelineRunsOptions.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "prune-pager-error")
+		return
+	}
+	runs, err := pager.GetAllWithContext(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "prune-list-runs-error")
+		return
+	}
+	sortPipelineRunsNewestFirst(runs)
+
+	result = &PipelineRunPruneReport{DryRun: pruneTektonPipelineRunsOptions.DryRun}
+	for index, deleteRun := range pruneTektonPipelineRunsOptions.Policy.selectRuns(runs, time.Now()) {
+		if deleteRun {
+			result.Results = append(result.Results, PipelineRunPruneResult{Run: &runs[index]})
+		} else {
+			result.Kept++
+		}
+	}
+	if pruneTektonPipelineRunsOptions.DryRun {
+		return
+	}
+
+	for index := range result.Results {
+		if index > 0 && pruneTektonPipelineRunsOptions.DeleteInterval > 0 {
+			timer := time.NewTimer(pruneTektonPipelineRunsOptions.DeleteInterval)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				err = core.SDKErrorf(ctx.Err(), "", "prune-cancelled", common.GetComponentInfo())
+				return
+			case <-timer.C:
+			}
+		}
+
+		pruned := &result.Results[index]
+		_, deleteErr := cdTektonPipeline.DeleteTektonPipelineRunWithContext(ctx, &DeleteTektonPipelineRunOptions{
+			PipelineID: pruneTektonPipelineRunsOptions.PipelineID,
+			ID:         pruned.Run.ID,
+			Headers:    pruneTektonPipelineRunsOptions.Headers,
+		})
+		if deleteErr != nil {
+			if ctx.Err() != nil {
+				err = core.SDKErrorf(ctx.Err(), "", "prune-cancelled", common.GetComponentInfo())
+				return
+			}
+			pruned.Error = core.RepurposeSDKProblem(deleteErr, "prune-delete-error")
+			continue
+		}
+		pruned.Deleted = true
+	}
+	return
+}
+
+// selectRuns returns, for each run, whether the policy deletes it. Runs must be sorted from newest to oldest.
+func (policy *PipelineRunRetentionPolicy) selectRuns(runs []PipelineRun, now time.Time) []bool {
+	// Number of runs per trigger already matched by each rule, used by the Last condition.
+	matched := make([]map[string]int, len(policy.Rules))
+	for index := range matched {
+		matched[index] = make(map[string]int)
+	}
+
+	selected := make([]bool, len(runs))
+	for runIndex := range runs {
+		run := &runs[runIndex]
+		keep, remove := false, false
+		for ruleIndex := range policy.Rules {
+			rule := &policy.Rules[ruleIndex]
+			if !rule.matches(run, now, matched[ruleIndex]) {
+				continue
+			}
+			if *rule.Action == PipelineRunRetentionRuleActionKeepConst {
+				keep = true
+			} else {
+				remove = true
+			}
+		}
+		selected[runIndex] = remove && !keep && run.RunStatus().IsTerminal()
+	}
+	return selected
+}
+
+// matches returns true if the rule matches the run. Runs are counted in matched, per trigger, for the Last condition.
+func (rule *PipelineRunRetentionRule) matches(run *PipelineRun, now time.Time, matched map[string]int) bool {
+	if len(rule.Statuses) > 0 && !slices.Contains(rule.Statuses, core.StringNilMapper(run.Status)) {
+		return false
+	}
+	triggerName := pipelineRunTriggerName(run)
+	if len(rule.TriggerNames) > 0 && !slices.Contains(rule.TriggerNames, triggerName) {
+		return false
+	}
+	if rule.OlderThan > 0 || rule.NewerThan > 0 {
+		createdAt := pipelineRunCreatedAt(run)
+		if createdAt == nil {
+			return false
+		}
+		age := now.Sub(*createdAt)
+		if rule.OlderThan > 0 && age <= rule.OlderThan {
+			return false
+		}
+		if rule.NewerThan > 0 && age >= rule.NewerThan {
+			return false
+		}
+	}
+	if rule.Last > 0 {
+		matched[triggerName]++
+		return matched[triggerName] <= rule.Last
+	}
+	return true
+}
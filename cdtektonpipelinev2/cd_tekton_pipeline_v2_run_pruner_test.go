@@ -0,0 +1,158 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CdTektonPipelineV2 PruneTektonPipelineRuns`, func() {
+	const day = 24 * time.Hour
+
+	var store *mockRunStore
+	var testServer *httptest.Server
+	var cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+
+	BeforeEach(func() {
+		now := time.Now()
+		store = newMockRunStore()
+		store.add("PipelineID", mockRun{ID: "p1", Status: "succeeded", Trigger: "push", CreatedAt: now.Add(-40 * day)})
+		store.add("PipelineID", mockRun{ID: "p2", Status: "failed", Trigger: "nightly", CreatedAt: now.Add(-41 * day)})
+		store.add("PipelineID", mockRun{ID: "p3", Status: "failed", Trigger: "push", CreatedAt: now.Add(-20 * day)})
+		store.add("PipelineID", mockRun{ID: "p4", Status: "succeeded", Trigger: "push", CreatedAt: now.Add(-10 * day)})
+		store.add("PipelineID", mockRun{ID: "p5", Status: "running", Trigger: "push", CreatedAt: now.Add(-8 * day)})
+		store.add("PipelineID", mockRun{ID: "p6", Status: "succeeded", Trigger: "push", CreatedAt: now.Add(-2 * day)})
+		testServer = store.server()
+		var serviceErr error
+		cdTektonPipelineService, serviceErr = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	rule := func(action string) *cdtektonpipelinev2.PipelineRunRetentionRule {
+		model, err := cdTektonPipelineService.NewPipelineRunRetentionRule(action)
+		Expect(err).To(BeNil())
+		return model
+	}
+	newPolicy := func(rules ...*cdtektonpipelinev2.PipelineRunRetentionRule) *cdtektonpipelinev2.PipelineRunRetentionPolicy {
+		policy := &cdtektonpipelinev2.PipelineRunRetentionPolicy{}
+		for _, model := range rules {
+			policy.Rules = append(policy.Rules, *model)
+		}
+		return policy
+	}
+	resultIDs := func(report *cdtektonpipelinev2.PipelineRunPruneReport) []string {
+		ids := []string{}
+		for _, result := range report.Results {
+			ids = append(ids, *result.Run.ID)
+		}
+		return ids
+	}
+
+	It(`Invoke PruneTektonPipelineRuns to apply a retention policy`, func() {
+		keepLast := rule(cdtektonpipelinev2.PipelineRunRetentionRuleActionKeepConst)
+		keepLast.Last = 1
+		keepFailed := rule(cdtektonpipelinev2.PipelineRunRetentionRuleActionKeepConst)
+		keepFailed.Statuses = []string{"failed"}
+		keepFailed.NewerThan = 30 * day
+		deleteSucceeded := rule(cdtektonpipelinev2.PipelineRunRetentionRuleActionDeleteConst)
+		deleteSucceeded.Statuses = []string{"succeeded"}
+		deleteSucceeded.OlderThan = 7 * day
+		deleteFailed := rule(cdtektonpipelinev2.PipelineRunRetentionRuleActionDeleteConst)
+		deleteFailed.Statuses = []string{"failed"}
+		deleteFailed.OlderThan = 30 * day
+
+		report, err := cdTektonPipelineService.PruneTektonPipelineRuns(cdTektonPipelineService.NewPruneTektonPipelineRunsOptions("PipelineID",
+			newPolicy(keepLast, keepFailed, deleteSucceeded, deleteFailed)).SetDeleteInterval(time.Millisecond))
+		Expect(err).To(BeNil())
+		Expect(resultIDs(report)).To(Equal([]string{"p4", "p1"}))
+		Expect(report.Kept).To(Equal(4))
+		Expect(report.Failed()).To(BeEmpty())
+		Expect(report.Results[0].Deleted).To(BeTrue())
+		Expect(store.requestLog()).To(ContainElements(
+			"DELETE /tekton_pipelines/PipelineID/pipeline_runs/p4",
+			"DELETE /tekton_pipelines/PipelineID/pipeline_runs/p1"))
+	})
+	It(`Invoke PruneTektonPipelineRuns without deleting active runs in dry-run mode`, func() {
+		report, err := cdTektonPipelineService.PruneTektonPipelineRuns(cdTektonPipelineService.NewPruneTektonPipelineRunsOptions("PipelineID",
+			newPolicy(rule(cdtektonpipelinev2.PipelineRunRetentionRuleActionDeleteConst))).SetDryRun(true))
+		Expect(err).To(BeNil())
+		Expect(report.DryRun).To(BeTrue())
+		Expect(resultIDs(report)).To(Equal([]string{"p6", "p4", "p3", "p1", "p2"}))
+		Expect(report.Kept).To(Equal(1))
+		for _, request := range store.requestLog() {
+			Expect(request).To(HavePrefix("GET "))
+		}
+	})
+	It(`Invoke PruneTektonPipelineRuns to report runs that could not be deleted`, func() {
+		store.failing = map[string]bool{"p4": true}
+		deleteSucceeded := rule(cdtektonpipelinev2.PipelineRunRetentionRuleActionDeleteConst)
+		deleteSucceeded.Statuses = []string{"succeeded"}
+		report, err := cdTektonPipelineService.PruneTektonPipelineRuns(cdTektonPipelineService.NewPruneTektonPipelineRunsOptions("PipelineID",
+			newPolicy(deleteSucceeded)))
+		Expect(err).To(BeNil())
+		Expect(resultIDs(report)).To(Equal([]string{"p6", "p4", "p1"}))
+		Expect(report.Failed()).To(HaveLen(1))
+		Expect(*report.Failed()[0].Run.ID).To(Equal("p4"))
+		Expect(report.Results[2].Deleted).To(BeTrue())
+	})
+	It(`Encode PipelineRunRetentionRule durations as strings`, func() {
+		retentionRule := rule(cdtektonpipelinev2.PipelineRunRetentionRuleActionDeleteConst)
+		retentionRule.OlderThan = 720 * time.Hour
+		data, err := json.Marshal(retentionRule)
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(Equal(`{"action":"delete","older_than":"720h0m0s"}`))
+
+		var decoded cdtektonpipelinev2.PipelineRunRetentionRule
+		Expect(json.Unmarshal(data, &decoded)).To(Succeed())
+		Expect(decoded).To(Equal(*retentionRule))
+		Expect(json.Unmarshal([]byte(`{"action":"keep","newer_than":"24h","older_than":3600000000000}`), &decoded)).To(Succeed())
+		Expect(*decoded.Action).To(Equal(cdtektonpipelinev2.PipelineRunRetentionRuleActionKeepConst))
+		Expect(decoded.NewerThan).To(Equal(24 * time.Hour))
+		Expect(decoded.OlderThan).To(Equal(time.Hour))
+		Expect(json.Unmarshal([]byte(`{"action":"keep","newer_than":"a day"}`), &decoded)).ToNot(Succeed())
+	})
+	It(`Invoke PruneTektonPipelineRuns with error: Operation validation and request error`, func() {
+		_, err := cdTektonPipelineService.PruneTektonPipelineRuns(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.PruneTektonPipelineRuns(cdTektonPipelineService.NewPruneTektonPipelineRunsOptions("PipelineID", nil))
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.PruneTektonPipelineRuns(cdTektonPipelineService.NewPruneTektonPipelineRunsOptions("PipelineID", newPolicy()))
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.NewPipelineRunRetentionRule("archive")
+		Expect(err).ToNot(BeNil())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err = cdTektonPipelineService.PruneTektonPipelineRunsWithContext(ctx, cdTektonPipelineService.NewPruneTektonPipelineRunsOptions("PipelineID",
+			newPolicy(rule(cdtektonpipelinev2.PipelineRunRetentionRuleActionDeleteConst))))
+		Expect(err).ToNot(BeNil())
+	})
+})
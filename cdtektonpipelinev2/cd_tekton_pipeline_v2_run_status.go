@@ -0,0 +1,168 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"slices"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// RunStatus : Typed status of a pipeline run.
+//
+// A run moves through the following statuses. Because runs are observed by polling, intermediate statuses may be
+// skipped, so every forward edge of the graph is allowed:
+//
+//	waiting ──┐
+//	   ▲      ▼
+//	   └── queued ──► pending ──► running ──► succeeded | failed | error | cancelled
+//
+// A run is "waiting" while a trigger's concurrency limit is reached, and "queued" until a worker accepts it. Both can
+// be followed by each other. Every status before the terminal ones can also end directly in "cancelled" or "error".
+// Terminal statuses never change; a rerun creates a new run.
+type RunStatus string
+
+// Constants associated with the RunStatus type.
+const (
+	RunStatusWaitingConst   RunStatus = "waiting"
+	RunStatusQueuedConst    RunStatus = "queued"
+	RunStatusPendingConst   RunStatus = "pending"
+	RunStatusRunningConst   RunStatus = "running"
+	RunStatusSucceededConst RunStatus = "succeeded"
+	RunStatusFailedConst    RunStatus = "failed"
+	RunStatusErrorConst     RunStatus = "error"
+	RunStatusCancelledConst RunStatus = "cancelled"
+
+	// RunStatusUnknownConst is used for statuses this SDK does not know about yet. It is neither active nor terminal.
+	RunStatusUnknownConst RunStatus = "unknown"
+)
+
+// runStatusTransitions lists, for every non-terminal status, the statuses that may be observed next.
+var runStatusTransitions = map[RunStatus][]RunStatus{
+	RunStatusWaitingConst: {RunStatusQueuedConst, RunStatusPendingConst, RunStatusRunningConst, RunStatusSucceededConst,
+		RunStatusFailedConst, RunStatusErrorConst, RunStatusCancelledConst},
+	RunStatusQueuedConst: {RunStatusWaitingConst, RunStatusPendingConst, RunStatusRunningConst, RunStatusSucceededConst,
+		RunStatusFailedConst, RunStatusErrorConst, RunStatusCancelledConst},
+	RunStatusPendingConst: {RunStatusRunningConst, RunStatusSucceededConst, RunStatusFailedConst, RunStatusErrorConst,
+		RunStatusCancelledConst},
+	RunStatusRunningConst: {RunStatusSucceededConst, RunStatusFailedConst, RunStatusErrorConst, RunStatusCancelledConst},
+}
+
+// ParseRunStatus converts a status reported by the service into a RunStatus. Statuses that are not known to this
+// SDK are returned as RunStatusUnknownConst.
+func ParseRunStatus(status string) RunStatus {
+	switch runStatus := RunStatus(status); runStatus {
+	case RunStatusWaitingConst, RunStatusQueuedConst, RunStatusPendingConst, RunStatusRunningConst,
+		RunStatusSucceededConst, RunStatusFailedConst, RunStatusErrorConst, RunStatusCancelledConst:
+		return runStatus
+	}
+	return RunStatusUnknownConst
+}
+
+// IsKnown returns true if the status is one of the statuses known to this SDK.
+func (status RunStatus) IsKnown() bool {
+	return status != RunStatusUnknownConst && ParseRunStatus(string(status)) == status
+}
+
+// IsTerminal returns true if the run has finished and its status will not change anymore.
+func (status RunStatus) IsTerminal() bool {
+	switch status {
+	case RunStatusSucceededConst, RunStatusFailedConst, RunStatusErrorConst, RunStatusCancelledConst:
+		return true
+	}
+	return false
+}
+
+// IsSuccess returns true if the run finished successfully.
+func (status RunStatus) IsSuccess() bool {
+	return status == RunStatusSucceededConst
+}
+
+// IsActive returns true if the run has not finished yet, including runs that are waiting for a concurrency slot.
+func (status RunStatus) IsActive() bool {
+	_, active := runStatusTransitions[status]
+	return active
+}
+
+// NextStatuses returns the statuses that may be observed after this one. It is empty for terminal and unknown
+// statuses.
+func (status RunStatus) NextStatuses() []RunStatus {
+	return slices.Clone(runStatusTransitions[status])
+}
+
+// CanTransitionTo returns true if a run in this status may be observed in the next status. Unknown statuses may
+// transition to and from any status, since nothing is known about them.
+func (status RunStatus) CanTransitionTo(next RunStatus) bool {
+	if !status.IsKnown() || !next.IsKnown() {
+		return true
+	}
+	return slices.Contains(runStatusTransitions[status], next)
+}
+
+// String returns the status as reported by the service.
+func (status RunStatus) String() string {
+	return string(status)
+}
+
+// UnmarshalText parses the status with ParseRunStatus.
+func (status *RunStatus) UnmarshalText(text []byte) error {
+	*status = ParseRunStatus(string(text))
+	return nil
+}
+
+// RunStatus returns the typed status of the pipeline run.
+func (pipelineRun *PipelineRun) RunStatus() RunStatus {
+	return ParseRunStatus(core.StringNilMapper(pipelineRun.Status))
+}
+
+// SetRunStatus : Allow user to set Status from a RunStatus
+func (_options *ListTektonPipelineRunsOptions) SetRunStatus(status RunStatus) *ListTektonPipelineRunsOptions {
+	_options.Status = core.StringPtr(string(status))
+	return _options
+}
+
+// PipelineStatus : Typed status of a Tekton pipeline.
+type PipelineStatus string
+
+// Constants associated with the PipelineStatus type.
+const (
+	PipelineStatusConfiguredConst  PipelineStatus = TektonPipelineStatusConfiguredConst
+	PipelineStatusConfiguringConst PipelineStatus = TektonPipelineStatusConfiguringConst
+
+	// PipelineStatusUnknownConst is used for statuses this SDK does not know about yet.
+	PipelineStatusUnknownConst PipelineStatus = "unknown"
+)
+
+// ParsePipelineStatus converts a status reported by the service into a PipelineStatus. Statuses that are not known to
+// this SDK are returned as PipelineStatusUnknownConst.
+func ParsePipelineStatus(status string) PipelineStatus {
+	switch pipelineStatus := PipelineStatus(status); pipelineStatus {
+	case PipelineStatusConfiguredConst, PipelineStatusConfiguringConst:
+		return pipelineStatus
+	}
+	return PipelineStatusUnknownConst
+}
+
+// IsConfigured returns true if the pipeline is ready to run.
+func (status PipelineStatus) IsConfigured() bool {
+	return status == PipelineStatusConfiguredConst
+}
+
+// PipelineStatus returns the typed status of the Tekton pipeline.
+func (tektonPipeline *TektonPipeline) PipelineStatus() PipelineStatus {
+	return ParsePipelineStatus(core.StringNilMapper(tektonPipeline.Status))
+}
@@ -0,0 +1,79 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"encoding/json"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CdTektonPipelineV2 RunStatus`, func() {
+	DescribeTable(`RunStatus predicates`,
+		func(status string, expected cdtektonpipelinev2.RunStatus, terminal bool, success bool, active bool) {
+			runStatus := cdtektonpipelinev2.ParseRunStatus(status)
+			Expect(runStatus).To(Equal(expected))
+			Expect(runStatus.IsTerminal()).To(Equal(terminal))
+			Expect(runStatus.IsSuccess()).To(Equal(success))
+			Expect(runStatus.IsActive()).To(Equal(active))
+		},
+		Entry(`waiting`, "waiting", cdtektonpipelinev2.RunStatusWaitingConst, false, false, true),
+		Entry(`queued`, "queued", cdtektonpipelinev2.RunStatusQueuedConst, false, false, true),
+		Entry(`pending`, "pending", cdtektonpipelinev2.RunStatusPendingConst, false, false, true),
+		Entry(`running`, "running", cdtektonpipelinev2.RunStatusRunningConst, false, false, true),
+		Entry(`succeeded`, "succeeded", cdtektonpipelinev2.RunStatusSucceededConst, true, true, false),
+		Entry(`failed`, "failed", cdtektonpipelinev2.RunStatusFailedConst, true, false, false),
+		Entry(`error`, "error", cdtektonpipelinev2.RunStatusErrorConst, true, false, false),
+		Entry(`cancelled`, "cancelled", cdtektonpipelinev2.RunStatusCancelledConst, true, false, false),
+		Entry(`new status`, "paused", cdtektonpipelinev2.RunStatusUnknownConst, false, false, false),
+		Entry(`empty status`, "", cdtektonpipelinev2.RunStatusUnknownConst, false, false, false),
+	)
+
+	It(`Invoke CanTransitionTo to follow the transition graph`, func() {
+		Expect(cdtektonpipelinev2.RunStatusQueuedConst.CanTransitionTo(cdtektonpipelinev2.RunStatusPendingConst)).To(BeTrue())
+		Expect(cdtektonpipelinev2.RunStatusQueuedConst.CanTransitionTo(cdtektonpipelinev2.RunStatusSucceededConst)).To(BeTrue())
+		Expect(cdtektonpipelinev2.RunStatusWaitingConst.CanTransitionTo(cdtektonpipelinev2.RunStatusQueuedConst)).To(BeTrue())
+		Expect(cdtektonpipelinev2.RunStatusRunningConst.CanTransitionTo(cdtektonpipelinev2.RunStatusPendingConst)).To(BeFalse())
+		Expect(cdtektonpipelinev2.RunStatusSucceededConst.CanTransitionTo(cdtektonpipelinev2.RunStatusRunningConst)).To(BeFalse())
+		Expect(cdtektonpipelinev2.RunStatusRunningConst.CanTransitionTo(cdtektonpipelinev2.RunStatus("paused"))).To(BeTrue())
+		Expect(cdtektonpipelinev2.RunStatusSucceededConst.NextStatuses()).To(BeEmpty())
+		Expect(cdtektonpipelinev2.RunStatusRunningConst.NextStatuses()).To(ConsistOf(
+			cdtektonpipelinev2.RunStatusSucceededConst, cdtektonpipelinev2.RunStatusFailedConst,
+			cdtektonpipelinev2.RunStatusErrorConst, cdtektonpipelinev2.RunStatusCancelledConst))
+	})
+	It(`Invoke RunStatus accessors on models`, func() {
+		run := &cdtektonpipelinev2.PipelineRun{Status: core.StringPtr("waiting")}
+		Expect(run.RunStatus()).To(Equal(cdtektonpipelinev2.RunStatusWaitingConst))
+		Expect((&cdtektonpipelinev2.PipelineRun{}).RunStatus()).To(Equal(cdtektonpipelinev2.RunStatusUnknownConst))
+
+		var decoded struct {
+			Status cdtektonpipelinev2.RunStatus `json:"status"`
+		}
+		Expect(json.Unmarshal([]byte(`{"status":"archived"}`), &decoded)).To(Succeed())
+		Expect(decoded.Status).To(Equal(cdtektonpipelinev2.RunStatusUnknownConst))
+
+		options := new(cdtektonpipelinev2.ListTektonPipelineRunsOptions).SetRunStatus(cdtektonpipelinev2.RunStatusRunningConst)
+		Expect(*options.Status).To(Equal(cdtektonpipelinev2.ListTektonPipelineRunsOptionsStatusRunningConst))
+
+		pipeline := &cdtektonpipelinev2.TektonPipeline{Status: core.StringPtr("configured")}
+		Expect(pipeline.PipelineStatus().IsConfigured()).To(BeTrue())
+		Expect(cdtektonpipelinev2.ParsePipelineStatus("deleting")).To(Equal(cdtektonpipelinev2.PipelineStatusUnknownConst))
+	})
+})
@@ -0,0 +1,338 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"time"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// DefaultWatchPollInterval is the delay between two polls of a PipelineRunWatcher when none is configured.
+const DefaultWatchPollInterval = 10 * time.Second
+
+// PipelineRunEventType : The kind of change reported by a PipelineRunEvent.
+type PipelineRunEventType string
+
+// Constants associated with the PipelineRunEventType type.
+const (
+	PipelineRunEventTypeCreatedConst       PipelineRunEventType = "created"
+	PipelineRunEventTypeStatusChangedConst PipelineRunEventType = "status_changed"
+	PipelineRunEventTypeDeletedConst       PipelineRunEventType = "deleted"
+)
+
+// PipelineRunEvent : A change of a pipeline run observed by a PipelineRunWatcher.
+type PipelineRunEvent struct {
+	// The kind of change.
+	Type PipelineRunEventType
+
+	// The ID of the pipeline to which the run belongs.
+	PipelineID string
+
+	// The run as listed by the poll that observed the change. For deleted runs this is the last listed state.
+	Run *PipelineRun
+
+	// The status of the run before the change. Empty for created runs.
+	PreviousStatus string
+}
+
+// WatchTektonPipelineRunsOptions : The options used to create a PipelineRunWatcher.
+type WatchTektonPipelineRunsOptions struct {
+	// The IDs of the Tekton pipelines to watch.
+	PipelineIDs []string `json:"pipeline_ids" validate:"required,min=1,dive,ne="`
+
+	// Only report events of runs started by one of these triggers. All triggers are reported when empty.
+	TriggerNames []string `json:"trigger_names,omitempty"`
+
+	// Only report events whose resulting status is one of these statuses; for deleted runs the last known status is
+	// used. All statuses are reported when empty.
+	Statuses []string `json:"statuses,omitempty"`
+
+	// Maximum number of most recent runs observed per pipeline. When zero every run is listed on every poll. Runs that
+	// drop out of this window are forgotten without being reported as deleted.
+	MaxRuns int
+
+	// Page size used when listing runs.
+	Limit *int64 `json:"limit,omitempty"`
+
+	// Delay between two polls. Defaults to DefaultWatchPollInterval.
+	PollInterval time.Duration
+
+	// When true, the first poll reports every existing run as created. Otherwise it only records their state.
+	EmitInitial bool
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewWatchTektonPipelineRunsOptions : Instantiate WatchTektonPipelineRunsOptions
+func (*CdTektonPipelineV2) NewWatchTektonPipelineRunsOptions(pipelineIDs []string) *WatchTektonPipelineRunsOptions {
+	return &WatchTektonPipelineRunsOptions{
+		PipelineIDs: pipelineIDs,
+	}
+}
+
+// SetPipelineIDs : Allow user to set PipelineIDs
+func (_options *WatchTektonPipelineRunsOptions) SetPipelineIDs(pipelineIDs []string) *WatchTektonPipelineRunsOptions {
+	_options.PipelineIDs = pipelineIDs
+	return _options
+}
+
+// SetTriggerNames : Allow user to set TriggerNames
+func (_options *WatchTektonPipelineRunsOptions) SetTriggerNames(triggerNames []string) *WatchTektonPipelineRunsOptions {
+	_options.TriggerNames = triggerNames
+	return _options
+}
+
+// SetStatuses : Allow user to set Statuses
+func (_options *WatchTektonPipelineRunsOptions) SetStatuses(statuses []string) *WatchTektonPipelineRunsOptions {
+	_options.Statuses = statuses
+	return _options
+}
+
+// SetMaxRuns : Allow user to set MaxRuns
+func (_options *WatchTektonPipelineRunsOptions) SetMaxRuns(maxRuns int) *WatchTektonPipelineRunsOptions {
+	_options.MaxRuns = maxRuns
+	return _options
+}
+
+// SetLimit : Allow user to set Limit
+func (_options *WatchTektonPipelineRunsOptions) SetLimit(limit int64) *WatchTektonPipelineRunsOptions {
+	_options.Limit = core.Int64Ptr(limit)
+	return _options
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (_options *WatchTektonPipelineRunsOptions) SetPollInterval(pollInterval time.Duration) *WatchTektonPipelineRunsOptions {
+	_options.PollInterval = pollInterval
+	return _options
+}
+
+// SetEmitInitial : Allow user to set EmitInitial
+func (_options *WatchTektonPipelineRunsOptions) SetEmitInitial(emitInitial bool) *WatchTektonPipelineRunsOptions {
+	_options.EmitInitial = emitInitial
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *WatchTektonPipelineRunsOptions) SetHeaders(param map[string]string) *WatchTektonPipelineRunsOptions {
+	options.Headers = param
+	return options
+}
+
+// PipelineRunWatcher can be used to observe the runs of one or more pipelines. It keeps the last listed state of every
+// run so that each creation, status change and deletion is reported exactly once.
+type PipelineRunWatcher struct {
+	options *WatchTektonPipelineRunsOptions
+	client  *CdTektonPipelineV2
+	known   map[string]map[string]*PipelineRun
+}
+
+// NewPipelineRunWatcher returns a new PipelineRunWatcher instance.
+func (cdTektonPipeline *CdTektonPipelineV2) NewPipelineRunWatcher(options *WatchTektonPipelineRunsOptions) (watcher *PipelineRunWatcher, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(options, "options")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	var optionsCopy WatchTektonPipelineRunsOptions = *options
+	if optionsCopy.PollInterval <= 0 {
+		optionsCopy.PollInterval = DefaultWatchPollInterval
+	}
+	watcher = &PipelineRunWatcher{
+		options: &optionsCopy,
+		client:  cdTektonPipeline,
+		known:   make(map[string]map[string]*PipelineRun),
+	}
+	return
+}
+
+// PollWithContext lists the runs of every watched pipeline once and returns the events since the previous poll. The
+// recorded state is only updated when every pipeline was listed successfully, so a failed poll can simply be retried.
+func (watcher *PipelineRunWatcher) PollWithContext(ctx context.Context) (events []PipelineRunEvent, err error) {
+	known := make(map[string]map[string]*PipelineRun, len(watcher.options.PipelineIDs))
+	var polled []PipelineRunEvent
+	for _, pipelineID := range watcher.options.PipelineIDs {
+		var runs []PipelineRun
+		runs, err = watcher.listRuns(ctx, pipelineID)
+		if err != nil {
+			return
+		}
+
+		previous, initialized := watcher.known[pipelineID]
+		current := make(map[string]*PipelineRun, len(runs))
+		for index := range runs {
+			run := &runs[index]
+			if run.ID == nil {
+				continue
+			}
+			current[*run.ID] = run
+
+			old, seen := previous[*run.ID]
+			switch {
+			case !seen && (initialized || watcher.options.EmitInitial):
+				polled = watcher.appendEvent(polled, PipelineRunEventTypeCreatedConst, pipelineID, run, "")
+			case seen && core.StringNilMapper(old.Status) != core.StringNilMapper(run.Status):
+				polled = watcher.appendEvent(polled, PipelineRunEventTypeStatusChangedConst, pipelineID, run, core.StringNilMapper(old.Status))
+			}
+		}
+
+		// With a bounded window, runs older than the oldest listed run have dropped out of the window rather than
+		// been deleted.
+		var oldest *time.Time
+		if watcher.options.MaxRuns > 0 && len(runs) >= watcher.options.MaxRuns {
+			oldest = pipelineRunCreatedAt(&runs[len(runs)-1])
+		}
+		for _, runID := range slices.Sorted(maps.Keys(previous)) {
+			if _, listed := current[runID]; listed {
+				continue
+			}
+			old := previous[runID]
+			if createdAt := pipelineRunCreatedAt(old); oldest != nil && createdAt != nil && createdAt.Before(*oldest) {
+				continue
+			}
+			polled = watcher.appendEvent(polled, PipelineRunEventTypeDeletedConst, pipelineID, old, core.StringNilMapper(old.Status))
+		}
+
+		known[pipelineID] = current
+	}
+
+	watcher.known = known
+	events = polled
+	return
+}
+
+// Poll invokes PollWithContext() using context.Background() as the Context parameter.
+func (watcher *PipelineRunWatcher) Poll() (events []PipelineRunEvent, err error) {
+	events, err = watcher.PollWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// Watch polls the watched pipelines until the context is done and emits every event on the returned channel. Both
+// channels are closed when watching stops, either because the context is done or because a poll failed; at most one
+// error is sent.
+func (watcher *PipelineRunWatcher) Watch(ctx context.Context) (<-chan PipelineRunEvent, <-chan error) {
+	events := make(chan PipelineRunEvent)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(events)
+
+		for {
+			polled, err := watcher.PollWithContext(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					errs <- err
+				}
+				return
+			}
+			for _, event := range polled {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			timer := time.NewTimer(watcher.options.PollInterval)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+		}
+	}()
+
+	return events, errs
+}
+
+// listRuns lists the most recent runs of a pipeline, honoring the MaxRuns window.
+func (watcher *PipelineRunWatcher) listRuns(ctx context.Context, pipelineID string) (runs []PipelineRun, err error) {
+	listOptions := &ListTektonPipelineRunsOptions{
+		PipelineID: core.StringPtr(pipelineID),
+		Limit:      watcher.options.Limit,
+		Headers:    watcher.options.Headers,
+	}
+	if len(watcher.options.TriggerNames) == 1 {
+		listOptions.TriggerName = core.StringPtr(watcher.options.TriggerNames[0])
+	}
+
+	pager, err := watcher.client.NewTektonPipelineRunsPager(listOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "watch-pager-error")
+		return
+	}
+	for pager.HasNext() && (watcher.options.MaxRuns <= 0 || len(runs) < watcher.options.MaxRuns) {
+		var page []PipelineRun
+		page, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "watch-list-runs-error")
+			return
+		}
+		runs = append(runs, page...)
+	}
+	if watcher.options.MaxRuns > 0 && len(runs) > watcher.options.MaxRuns {
+		runs = runs[:watcher.options.MaxRuns]
+	}
+	return
+}
+
+// appendEvent appends an event if it passes the trigger and status filters.
+func (watcher *PipelineRunWatcher) appendEvent(events []PipelineRunEvent, eventType PipelineRunEventType, pipelineID string, run *PipelineRun, previousStatus string) []PipelineRunEvent {
+	if len(watcher.options.TriggerNames) > 0 && !slices.Contains(watcher.options.TriggerNames, pipelineRunTriggerName(run)) {
+		return events
+	}
+	if len(watcher.options.Statuses) > 0 && !slices.Contains(watcher.options.Statuses, core.StringNilMapper(run.Status)) {
+		return events
+	}
+	return append(events, PipelineRunEvent{
+		Type:           eventType,
+		PipelineID:     pipelineID,
+		Run:            run,
+		PreviousStatus: previousStatus,
+	})
+}
+
+// pipelineRunTriggerName returns the name of the trigger that started a run, or an empty string if it is unknown.
+func pipelineRunTriggerName(run *PipelineRun) string {
+	if trigger, ok := run.Trigger.(*Trigger); ok && trigger != nil {
+		return core.StringNilMapper(trigger.Name)
+	}
+	return ""
+}
+
+// pipelineRunCreatedAt returns the creation time of a run, or nil if it is unknown.
+func pipelineRunCreatedAt(run *PipelineRun) *time.Time {
+	if run.CreatedAt == nil {
+		return nil
+	}
+	createdAt := time.Time(*run.CreatedAt)
+	return &createdAt
+}
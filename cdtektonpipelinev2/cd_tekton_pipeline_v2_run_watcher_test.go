@@ -0,0 +1,153 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CdTektonPipelineV2 PipelineRunWatcher`, func() {
+	var store *mockRunStore
+	var testServer *httptest.Server
+	var cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+
+	BeforeEach(func() {
+		store = newMockRunStore()
+		store.add("pipeline-a", mockRun{ID: "a1", Status: "succeeded", Trigger: "nightly"})
+		store.add("pipeline-a", mockRun{ID: "a2", Status: "running", Trigger: "manual"})
+		store.add("pipeline-b", mockRun{ID: "b1", Status: "queued", Trigger: "nightly"})
+		testServer = store.server()
+		var serviceErr error
+		cdTektonPipelineService, serviceErr = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	describeEvents := func(events []cdtektonpipelinev2.PipelineRunEvent) []string {
+		descriptions := []string{}
+		for _, event := range events {
+			descriptions = append(descriptions, fmt.Sprintf("%s %s/%s %s->%s", event.Type, event.PipelineID, *event.Run.ID, event.PreviousStatus, *event.Run.Status))
+		}
+		return descriptions
+	}
+
+	It(`Invoke Poll to report every change exactly once`, func() {
+		watcher, err := cdTektonPipelineService.NewPipelineRunWatcher(
+			cdTektonPipelineService.NewWatchTektonPipelineRunsOptions([]string{"pipeline-a", "pipeline-b"}).SetLimit(1))
+		Expect(err).To(BeNil())
+
+		events, err := watcher.Poll()
+		Expect(err).To(BeNil())
+		Expect(events).To(BeEmpty())
+
+		store.setStatus("a2", "succeeded")
+		store.add("pipeline-b", mockRun{ID: "b2", Status: "pending", Trigger: "manual"})
+		store.remove("a1")
+
+		events, err = watcher.Poll()
+		Expect(err).To(BeNil())
+		Expect(describeEvents(events)).To(Equal([]string{
+			"status_changed pipeline-a/a2 running->succeeded",
+			"deleted pipeline-a/a1 succeeded->succeeded",
+			"created pipeline-b/b2 ->pending",
+		}))
+
+		events, err = watcher.Poll()
+		Expect(err).To(BeNil())
+		Expect(events).To(BeEmpty())
+	})
+	It(`Invoke Poll with trigger and status filters`, func() {
+		watcher, err := cdTektonPipelineService.NewPipelineRunWatcher(
+			cdTektonPipelineService.NewWatchTektonPipelineRunsOptions([]string{"pipeline-a", "pipeline-b"}).
+				SetTriggerNames([]string{"nightly"}).
+				SetStatuses([]string{"running", "failed"}).
+				SetEmitInitial(true))
+		Expect(err).To(BeNil())
+
+		events, err := watcher.Poll()
+		Expect(err).To(BeNil())
+		Expect(events).To(BeEmpty())
+
+		store.setStatus("b1", "running")
+		store.setStatus("a2", "failed")
+		events, err = watcher.Poll()
+		Expect(err).To(BeNil())
+		Expect(describeEvents(events)).To(Equal([]string{"status_changed pipeline-b/b1 queued->running"}))
+	})
+	It(`Invoke Poll with a bounded window`, func() {
+		watcher, err := cdTektonPipelineService.NewPipelineRunWatcher(
+			cdTektonPipelineService.NewWatchTektonPipelineRunsOptions([]string{"pipeline-a"}).SetMaxRuns(2))
+		Expect(err).To(BeNil())
+		_, err = watcher.Poll()
+		Expect(err).To(BeNil())
+
+		store.add("pipeline-a", mockRun{ID: "a3", Status: "queued", Trigger: "manual"})
+		events, err := watcher.Poll()
+		Expect(err).To(BeNil())
+		Expect(describeEvents(events)).To(Equal([]string{"created pipeline-a/a3 ->queued"}))
+	})
+	It(`Invoke Watch and receive events until the context is cancelled`, func() {
+		watcher, err := cdTektonPipelineService.NewPipelineRunWatcher(
+			cdTektonPipelineService.NewWatchTektonPipelineRunsOptions([]string{"pipeline-b"}).SetPollInterval(time.Millisecond))
+		Expect(err).To(BeNil())
+		_, err = watcher.Poll()
+		Expect(err).To(BeNil())
+
+		store.setStatus("b1", "running")
+		ctx, cancelFunc := context.WithCancel(context.Background())
+		events, errs := watcher.Watch(ctx)
+		event := <-events
+		Expect(event.Type).To(Equal(cdtektonpipelinev2.PipelineRunEventTypeStatusChangedConst))
+		Expect(event.PreviousStatus).To(Equal("queued"))
+		cancelFunc()
+		for range events {
+		}
+		Expect(<-errs).To(BeNil())
+	})
+	It(`Invoke NewPipelineRunWatcher and Poll with error: Operation validation and request error`, func() {
+		_, err := cdTektonPipelineService.NewPipelineRunWatcher(nil)
+		Expect(err).ToNot(BeNil())
+
+		_, err = cdTektonPipelineService.NewPipelineRunWatcher(cdTektonPipelineService.NewWatchTektonPipelineRunsOptions(nil))
+		Expect(err).ToNot(BeNil())
+
+		watcher, err := cdTektonPipelineService.NewPipelineRunWatcher(
+			cdTektonPipelineService.NewWatchTektonPipelineRunsOptions([]string{"pipeline-a"}))
+		Expect(err).To(BeNil())
+		testServer.Close()
+		_, err = watcher.Poll()
+		Expect(err).ToNot(BeNil())
+
+		events, errs := watcher.Watch(context.Background())
+		for range events {
+		}
+		Expect(<-errs).ToNot(BeNil())
+	})
+})
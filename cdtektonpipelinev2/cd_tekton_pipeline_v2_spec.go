@@ -0,0 +1,1074 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"sigs.k8s.io/yaml"
+)
+
+// TektonPipelineSpec : The declarative configuration of a Tekton pipeline, usually kept in a YAML or JSON file next to
+// the pipeline definitions so that changes to it are reviewed like code. The fields use the names of the API.
+//
+// A field or list that is omitted, or null, is not managed by the spec: the service keeps its current value. An empty
+// list means that the pipeline has none of these resources, and the existing ones are deleted.
+type TektonPipelineSpec struct {
+	// The worker running the pipeline.
+	Worker *WorkerIdentity `json:"worker,omitempty"`
+
+	// Whether the pipeline sends notifications to the toolchain's integrations.
+	EnableNotifications *bool `json:"enable_notifications,omitempty"`
+
+	// Whether the pipeline clones repositories partially.
+	EnablePartialCloning *bool `json:"enable_partial_cloning,omitempty"`
+
+	// The definitions, identified by their repository URL and path.
+	Definitions []TektonPipelineDefinitionSpec `json:"definitions" validate:"dive"`
+
+	// The pipeline properties, identified by their name.
+	Properties []TektonPipelinePropertySpec `json:"properties" validate:"dive"`
+
+	// The triggers, identified by their name.
+	Triggers []TektonPipelineTriggerSpec `json:"triggers" validate:"dive"`
+
+	// Symbolic names for the repository tools of the toolchain, mapped to their IDs. The `tool.id` of a definition can be
+	// one of these names instead of an ID, so that the spec is applied to a pipeline of another toolchain by only
+	// changing this map.
+	Tools map[string]string `json:"tools,omitempty"`
+}
+
+// TektonPipelineSpecSecurePlaceholder is the value of the secure properties and trigger secrets of exported specs,
+// since the service does not return secure values. A placeholder value is never written to the pipeline: it keeps the
+// current value, and cannot be used to create a secure value.
+const TektonPipelineSpecSecurePlaceholder = "<secure>"
+
+// isSecurePlaceholder returns true when a secure value is the placeholder of exported specs.
+func isSecurePlaceholder(value *string) bool {
+	return value != nil && *value == TektonPipelineSpecSecurePlaceholder
+}
+
+// TektonPipelineDefinitionSpec : A definition of a TektonPipelineSpec.
+type TektonPipelineDefinitionSpec struct {
+	// The repository and path of the definition.
+	Source *DefinitionSource `json:"source" validate:"required"`
+}
+
+// TektonPipelinePropertySpec : A pipeline or trigger property of a TektonPipelineSpec. A property is replaced as a
+// whole, so fields that are omitted are cleared.
+type TektonPipelinePropertySpec struct {
+	Name *string `json:"name" validate:"required,ne="`
+
+	Type *string `json:"type" validate:"required,ne="`
+
+	// The value of the property. The values of secure properties cannot be read back from the service, so they are only
+	// compared when ReplaceSecureValues is set in the PlanTektonPipelineSpec options, and they can be
+	// TektonPipelineSpecSecurePlaceholder.
+	Value *string `json:"value,omitempty"`
+
+	Enum []string `json:"enum,omitempty"`
+
+	Locked *bool `json:"locked,omitempty"`
+
+	Path *string `json:"path,omitempty"`
+}
+
+// TektonPipelineTriggerSpec : A trigger of a TektonPipelineSpec. Fields that are omitted keep the value of the service.
+type TektonPipelineTriggerSpec struct {
+	Type *string `json:"type" validate:"required,ne="`
+
+	Name *string `json:"name" validate:"required,ne="`
+
+	EventListener *string `json:"event_listener" validate:"required,ne="`
+
+	Tags []string `json:"tags,omitempty"`
+
+	Worker *WorkerIdentity `json:"worker,omitempty"`
+
+	MaxConcurrentRuns *int64 `json:"max_concurrent_runs,omitempty"`
+
+	LimitWaitingRuns *bool `json:"limit_waiting_runs,omitempty"`
+
+	Enabled *bool `json:"enabled,omitempty"`
+
+	Secret *GenericSecret `json:"secret,omitempty"`
+
+	Cron *string `json:"cron,omitempty"`
+
+	Timezone *string `json:"timezone,omitempty"`
+
+	Source *TriggerSourcePrototype `json:"source,omitempty"`
+
+	Events []string `json:"events,omitempty"`
+
+	Filter *string `json:"filter,omitempty"`
+
+	Favorite *bool `json:"favorite,omitempty"`
+
+	EnableEventsFromForks *bool `json:"enable_events_from_forks,omitempty"`
+
+	DisableDraftEvents *bool `json:"disable_draft_events,omitempty"`
+
+	// The trigger properties, identified by their name. Like the lists of the pipeline, an omitted list is not managed
+	// and an empty list deletes the existing trigger properties.
+	Properties []TektonPipelinePropertySpec `json:"properties" validate:"dive"`
+}
+
+// ParseTektonPipelineSpec parses and validates a TektonPipelineSpec from YAML or JSON. Unknown fields are rejected, so
+// that misspelled fields are not silently ignored.
+func ParseTektonPipelineSpec(data []byte) (spec *TektonPipelineSpec, err error) {
+	spec = &TektonPipelineSpec{}
+	err = yaml.UnmarshalStrict(data, spec)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "spec-parse-error", common.GetComponentInfo())
+		return nil, err
+	}
+	err = spec.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// LoadTektonPipelineSpec reads a TektonPipelineSpec from a YAML or JSON file.
+func LoadTektonPipelineSpec(path string) (spec *TektonPipelineSpec, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "spec-read-error", common.GetComponentInfo())
+		return
+	}
+	return ParseTektonPipelineSpec(data)
+}
+
+// Validate checks the required fields of the spec, that no resource is declared twice and that the schedules of the
+// timer triggers are valid.
+func (spec *TektonPipelineSpec) Validate() (err error) {
+	err = core.ValidateStruct(spec, "spec")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	duplicate := func(resource string, names []string) error {
+		seen := make(map[string]bool)
+		for _, name := range names {
+			if seen[name] {
+				return core.SDKErrorf(nil, fmt.Sprintf("%s '%s' is declared more than once", resource, name), "invalid-spec", common.GetComponentInfo())
+			}
+			seen[name] = true
+		}
+		return nil
+	}
+
+	var names []string
+	for _, definition := range spec.Definitions {
+		names = append(names, definitionSpecName(definition.Source))
+	}
+	if err = duplicate(TektonPipelinePlanResourceDefinitionConst, names); err != nil {
+		return
+	}
+	names = nil
+	for _, property := range spec.Properties {
+		names = append(names, *property.Name)
+	}
+	if err = duplicate(TektonPipelinePlanResourcePropertyConst, names); err != nil {
+		return
+	}
+	names = nil
+	for _, trigger := range spec.Triggers {
+		names = append(names, *trigger.Name)
+		if *trigger.Type == CreateTektonPipelineTriggerOptionsTypeTimerConst && trigger.Cron != nil {
+			if _, cronErr := ParseCronSchedule(*trigger.Cron, core.StringNilMapper(trigger.Timezone)); cronErr != nil {
+				err = core.SDKErrorf(nil, fmt.Sprintf("invalid schedule of trigger '%s': %s", *trigger.Name, cronErr.Error()), "invalid-spec", common.GetComponentInfo())
+				return
+			}
+		}
+		var propertyNames []string
+		for _, property := range trigger.Properties {
+			propertyNames = append(propertyNames, *trigger.Name+"/"+*property.Name)
+		}
+		if err = duplicate(TektonPipelinePlanResourceTriggerPropertyConst, propertyNames); err != nil {
+			return
+		}
+	}
+	return duplicate(TektonPipelinePlanResourceTriggerConst, names)
+}
+
+// Constants associated with the TektonPipelinePlanAction.Action property.
+const (
+	TektonPipelinePlanActionCreateConst  = "create"
+	TektonPipelinePlanActionDeleteConst  = "delete"
+	TektonPipelinePlanActionReplaceConst = "replace"
+	TektonPipelinePlanActionUpdateConst  = "update"
+)
+
+// Constants associated with the TektonPipelinePlanAction.Resource property.
+const (
+	TektonPipelinePlanResourceDefinitionConst      = "definition"
+	TektonPipelinePlanResourcePipelineConst        = "pipeline"
+	TektonPipelinePlanResourcePropertyConst        = "property"
+	TektonPipelinePlanResourceTriggerConst         = "trigger"
+	TektonPipelinePlanResourceTriggerPropertyConst = "trigger_property"
+)
+
+// TektonPipelinePlan : The changes that make a Tekton pipeline match a TektonPipelineSpec, computed by
+// PlanTektonPipelineSpec and carried out by ApplyTektonPipelinePlan.
+type TektonPipelinePlan struct {
+	PipelineID string
+
+	// The actions, in the order in which they are applied: the pipeline update, the creates, replaces and updates of
+	// definitions, properties, triggers and trigger properties, then the deletes in the reverse order.
+	Actions []TektonPipelinePlanAction
+}
+
+// TektonPipelinePlanAction : A change of a TektonPipelinePlan.
+type TektonPipelinePlanAction struct {
+	// The action, one of the TektonPipelinePlanAction*Const constants.
+	Action string
+
+	// The resource, one of the TektonPipelinePlanResource*Const constants.
+	Resource string
+
+	// The name of the resource: the property or trigger name, "<trigger>/<property>" for trigger properties, and
+	// "<url>/<path>" for definitions. Empty for the pipeline.
+	Name string
+
+	// The ID of the existing definition or trigger, for replaces, updates and deletes.
+	ID string
+
+	// The fields that differ from the spec, for replaces and updates.
+	Changes []string
+
+	// True once the action was applied by ApplyTektonPipelinePlan.
+	Applied bool
+
+	pipelinePatch *TektonPipelinePatch
+	definition    *DefinitionSource
+	property      *TektonPipelinePropertySpec
+	trigger       *TektonPipelineTriggerSpec
+	triggerName   string
+}
+
+// IsEmpty returns true when the pipeline already matches the spec.
+func (plan *TektonPipelinePlan) IsEmpty() bool {
+	return len(plan.Actions) == 0
+}
+
+// String renders the plan for review, one action per line.
+func (plan *TektonPipelinePlan) String() string {
+	if plan.IsEmpty() {
+		return fmt.Sprintf("pipeline %s is up to date\n", plan.PipelineID)
+	}
+	var builder strings.Builder
+	for _, action := range plan.Actions {
+		symbol := "~"
+		switch action.Action {
+		case TektonPipelinePlanActionCreateConst:
+			symbol = "+"
+		case TektonPipelinePlanActionDeleteConst:
+			symbol = "-"
+		}
+		fmt.Fprintf(&builder, "%s %s %s", symbol, action.Action, action.Resource)
+		if action.Name != "" {
+			fmt.Fprintf(&builder, " %q", action.Name)
+		}
+		if len(action.Changes) > 0 {
+			fmt.Fprintf(&builder, " (%s)", strings.Join(action.Changes, ", "))
+		}
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
+
+// PlanTektonPipelineSpecOptions : The PlanTektonPipelineSpec options.
+type PlanTektonPipelineSpecOptions struct {
+	// The Tekton pipeline ID.
+	PipelineID *string `json:"pipeline_id" validate:"required,ne="`
+
+	// The spec that the pipeline must match.
+	Spec *TektonPipelineSpec `json:"spec" validate:"required"`
+
+	// When true, the secure properties and trigger secrets that have a value in the spec are always replaced, since their
+	// values cannot be compared with the masked values of the service.
+	ReplaceSecureValues bool `json:"replace_secure_values,omitempty"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewPlanTektonPipelineSpecOptions : Instantiate PlanTektonPipelineSpecOptions
+func (*CdTektonPipelineV2) NewPlanTektonPipelineSpecOptions(pipelineID string, spec *TektonPipelineSpec) *PlanTektonPipelineSpecOptions {
+	return &PlanTektonPipelineSpecOptions{
+		PipelineID: core.StringPtr(pipelineID),
+		Spec:       spec,
+	}
+}
+
+// SetPipelineID : Allow user to set PipelineID
+func (_options *PlanTektonPipelineSpecOptions) SetPipelineID(pipelineID string) *PlanTektonPipelineSpecOptions {
+	_options.PipelineID = core.StringPtr(pipelineID)
+	return _options
+}
+
+// SetSpec : Allow user to set Spec
+func (_options *PlanTektonPipelineSpecOptions) SetSpec(spec *TektonPipelineSpec) *PlanTektonPipelineSpecOptions {
+	_options.Spec = spec
+	return _options
+}
+
+// SetReplaceSecureValues : Allow user to set ReplaceSecureValues
+func (_options *PlanTektonPipelineSpecOptions) SetReplaceSecureValues(replaceSecureValues bool) *PlanTektonPipelineSpecOptions {
+	_options.ReplaceSecureValues = replaceSecureValues
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *PlanTektonPipelineSpecOptions) SetHeaders(param map[string]string) *PlanTektonPipelineSpecOptions {
+	options.Headers = param
+	return options
+}
+
+// PlanTektonPipelineSpec : Compute the changes that make a pipeline match a spec
+// This reads the configuration of the pipeline and compares it with the spec, without changing the pipeline. The plan
+// can be reviewed with its String method, then applied with ApplyTektonPipelinePlan.
+func (cdTektonPipeline *CdTektonPipelineV2) PlanTektonPipelineSpec(planTektonPipelineSpecOptions *PlanTektonPipelineSpecOptions) (result *TektonPipelinePlan, err error) {
+	result, err = cdTektonPipeline.PlanTektonPipelineSpecWithContext(context.Background(), planTektonPipelineSpecOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// PlanTektonPipelineSpecWithContext is an alternate form of the PlanTektonPipelineSpec method which supports a Context parameter.
+func (cdTektonPipeline *CdTektonPipelineV2) PlanTektonPipelineSpecWithContext(ctx context.Context, planTektonPipelineSpecOptions *PlanTektonPipelineSpecOptions) (result *TektonPipelinePlan, err error) {
+	err = core.ValidateNotNil(planTektonPipelineSpecOptions, "planTektonPipelineSpecOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(planTektonPipelineSpecOptions, "planTektonPipelineSpecOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	spec := planTektonPipelineSpecOptions.Spec
+	err = spec.Validate()
+	if err != nil {
+		return
+	}
+
+	planner := &tektonPipelinePlanner{
+		client:  cdTektonPipeline,
+		ctx:     ctx,
+		options: planTektonPipelineSpecOptions,
+		plan:    &TektonPipelinePlan{PipelineID: *planTektonPipelineSpecOptions.PipelineID},
+	}
+	if err = planner.planPipeline(); err != nil {
+		return
+	}
+	if spec.Definitions != nil {
+		if err = planner.planDefinitions(); err != nil {
+			return
+		}
+	}
+	if spec.Properties != nil {
+		if err = planner.planProperties(); err != nil {
+			return
+		}
+	}
+	if spec.Triggers != nil {
+		if err = planner.planTriggers(); err != nil {
+			return
+		}
+	}
+	// Delete the resources in the reverse order, so that nothing is removed while a remaining resource may still
+	// refer to it.
+	slices.SortStableFunc(planner.deletes, func(a TektonPipelinePlanAction, b TektonPipelinePlanAction) int {
+		return slices.Index(tektonPipelinePlanResourceOrder, b.Resource) - slices.Index(tektonPipelinePlanResourceOrder, a.Resource)
+	})
+	result = planner.plan
+	result.Actions = append(result.Actions, planner.deletes...)
+
+	// A placeholder keeps the secure value of the pipeline, so it cannot be written.
+	for _, action := range result.Actions {
+		if (action.property != nil && action.Action != TektonPipelinePlanActionDeleteConst && isSecurePlaceholder(action.property.Value)) ||
+			(action.trigger != nil && action.Action == TektonPipelinePlanActionCreateConst && action.trigger.Secret != nil && isSecurePlaceholder(action.trigger.Secret.Value)) {
+			err = core.SDKErrorf(nil, fmt.Sprintf("cannot %s %s '%s' with the placeholder of a secure value", action.Action, action.Resource, action.Name), "spec-secure-placeholder", common.GetComponentInfo())
+			return nil, err
+		}
+	}
+	return
+}
+
+// resolveTool returns the source of a definition with the symbolic tool name replaced by the tool ID.
+func (spec *TektonPipelineSpec) resolveTool(source *DefinitionSource) *DefinitionSource {
+	if source.Properties.Tool == nil || spec.Tools[core.StringNilMapper(source.Properties.Tool.ID)] == "" {
+		return source
+	}
+	properties := *source.Properties
+	properties.Tool = &Tool{ID: core.StringPtr(spec.Tools[*source.Properties.Tool.ID])}
+	return &DefinitionSource{Type: source.Type, Properties: &properties}
+}
+
+// tektonPipelinePlanResourceOrder is the order in which the resources are created, replaced and updated.
+var tektonPipelinePlanResourceOrder = []string{
+	TektonPipelinePlanResourcePipelineConst,
+	TektonPipelinePlanResourceDefinitionConst,
+	TektonPipelinePlanResourcePropertyConst,
+	TektonPipelinePlanResourceTriggerConst,
+	TektonPipelinePlanResourceTriggerPropertyConst,
+}
+
+// tektonPipelinePlanner computes a TektonPipelinePlan. The deletes are collected separately, since they are applied
+// after all the other actions.
+type tektonPipelinePlanner struct {
+	client  *CdTektonPipelineV2
+	ctx     context.Context
+	options *PlanTektonPipelineSpecOptions
+	plan    *TektonPipelinePlan
+	deletes []TektonPipelinePlanAction
+}
+
+func (planner *tektonPipelinePlanner) add(action TektonPipelinePlanAction) {
+	planner.plan.Actions = append(planner.plan.Actions, action)
+}
+
+func (planner *tektonPipelinePlanner) addDelete(action TektonPipelinePlanAction) {
+	action.Action = TektonPipelinePlanActionDeleteConst
+	planner.deletes = append(planner.deletes, action)
+}
+
+func (planner *tektonPipelinePlanner) planPipeline() (err error) {
+	spec := planner.options.Spec
+	pipeline, _, err := planner.client.GetTektonPipelineWithContext(planner.ctx, &GetTektonPipelineOptions{
+		ID:      planner.options.PipelineID,
+		Headers: planner.options.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "spec-get-pipeline-error")
+		return
+	}
+
+	patch := &TektonPipelinePatch{}
+	var changes []string
+	if spec.Worker != nil && (pipeline.Worker == nil || !specStringEqual(spec.Worker.ID, pipeline.Worker.ID)) {
+		patch.Worker = spec.Worker
+		changes = append(changes, "worker")
+	}
+	if spec.EnableNotifications != nil && !specBoolEqual(spec.EnableNotifications, pipeline.EnableNotifications) {
+		patch.EnableNotifications = spec.EnableNotifications
+		changes = append(changes, "enable_notifications")
+	}
+	if spec.EnablePartialCloning != nil && !specBoolEqual(spec.EnablePartialCloning, pipeline.EnablePartialCloning) {
+		patch.EnablePartialCloning = spec.EnablePartialCloning
+		changes = append(changes, "enable_partial_cloning")
+	}
+	if len(changes) > 0 {
+		planner.add(TektonPipelinePlanAction{
+			Action:        TektonPipelinePlanActionUpdateConst,
+			Resource:      TektonPipelinePlanResourcePipelineConst,
+			ID:            *planner.options.PipelineID,
+			Changes:       changes,
+			pipelinePatch: patch,
+		})
+	}
+	return
+}
+
+func (planner *tektonPipelinePlanner) planDefinitions() (err error) {
+	definitions, _, err := planner.client.ListTektonPipelineDefinitionsWithContext(planner.ctx, &ListTektonPipelineDefinitionsOptions{
+		PipelineID: planner.options.PipelineID,
+		Headers:    planner.options.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "spec-list-definitions-error")
+		return
+	}
+
+	live := make(map[string]*Definition)
+	for index := range definitions.Definitions {
+		definition := &definitions.Definitions[index]
+		name := definitionSpecName(definition.Source)
+		if live[name] == nil {
+			live[name] = definition
+		} else {
+			planner.addDelete(TektonPipelinePlanAction{Resource: TektonPipelinePlanResourceDefinitionConst, Name: name, ID: *definition.ID})
+		}
+	}
+	for _, definitionSpec := range planner.options.Spec.Definitions {
+		source := planner.options.Spec.resolveTool(definitionSpec.Source)
+		name := definitionSpecName(source)
+		existing := live[name]
+		delete(live, name)
+		if existing == nil {
+			planner.add(TektonPipelinePlanAction{
+				Action:     TektonPipelinePlanActionCreateConst,
+				Resource:   TektonPipelinePlanResourceDefinitionConst,
+				Name:       name,
+				definition: source,
+			})
+			continue
+		}
+		if changes := definitionChanges(source, existing.Source); len(changes) > 0 {
+			planner.add(TektonPipelinePlanAction{
+				Action:     TektonPipelinePlanActionReplaceConst,
+				Resource:   TektonPipelinePlanResourceDefinitionConst,
+				Name:       name,
+				ID:         *existing.ID,
+				Changes:    changes,
+				definition: source,
+			})
+		}
+	}
+	for _, definition := range definitions.Definitions {
+		name := definitionSpecName(definition.Source)
+		if existing := live[name]; existing != nil && *existing.ID == *definition.ID {
+			planner.addDelete(TektonPipelinePlanAction{Resource: TektonPipelinePlanResourceDefinitionConst, Name: name, ID: *definition.ID})
+		}
+	}
+	return
+}
+
+func (planner *tektonPipelinePlanner) planProperties() (err error) {
+	properties, _, err := planner.client.ListTektonPipelinePropertiesWithContext(planner.ctx, &ListTektonPipelinePropertiesOptions{
+		PipelineID: planner.options.PipelineID,
+		Headers:    planner.options.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "spec-list-properties-error")
+		return
+	}
+
+	var live []TektonPipelinePropertySpec
+	for _, property := range properties.Properties {
+		live = append(live, TektonPipelinePropertySpec{
+			Name:   property.Name,
+			Type:   property.Type,
+			Value:  property.Value,
+			Enum:   property.Enum,
+			Locked: property.Locked,
+			Path:   property.Path,
+		})
+	}
+	planner.planPropertyList(TektonPipelinePlanResourcePropertyConst, "", "", planner.options.Spec.Properties, live)
+	return
+}
+
+func (planner *tektonPipelinePlanner) planTriggers() (err error) {
+	triggers, _, err := planner.client.ListTektonPipelineTriggersWithContext(planner.ctx, &ListTektonPipelineTriggersOptions{
+		PipelineID: planner.options.PipelineID,
+		Headers:    planner.options.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "spec-list-triggers-error")
+		return
+	}
+
+	live := make(map[string]*Trigger)
+	for _, triggerIntf := range triggers.Triggers {
+		if trigger, ok := triggerIntf.(*Trigger); ok {
+			live[core.StringNilMapper(trigger.Name)] = trigger
+		}
+	}
+	var propertyActions []TektonPipelinePlanAction
+	for index := range planner.options.Spec.Triggers {
+		triggerSpec := &planner.options.Spec.Triggers[index]
+		name := *triggerSpec.Name
+		existing := live[name]
+		delete(live, name)
+		if existing == nil {
+			planner.add(TektonPipelinePlanAction{
+				Action:   TektonPipelinePlanActionCreateConst,
+				Resource: TektonPipelinePlanResourceTriggerConst,
+				Name:     name,
+				trigger:  triggerSpec,
+			})
+			for propertyIndex := range triggerSpec.Properties {
+				propertyActions = append(propertyActions, TektonPipelinePlanAction{
+					Action:      TektonPipelinePlanActionCreateConst,
+					Resource:    TektonPipelinePlanResourceTriggerPropertyConst,
+					Name:        name + "/" + *triggerSpec.Properties[propertyIndex].Name,
+					property:    &triggerSpec.Properties[propertyIndex],
+					triggerName: name,
+				})
+			}
+			continue
+		}
+		if changes := triggerChanges(triggerSpec, existing, planner.options.ReplaceSecureValues); len(changes) > 0 {
+			planner.add(TektonPipelinePlanAction{
+				Action:   TektonPipelinePlanActionUpdateConst,
+				Resource: TektonPipelinePlanResourceTriggerConst,
+				Name:     name,
+				ID:       *existing.ID,
+				Changes:  changes,
+				trigger:  triggerSpec,
+			})
+		}
+		if triggerSpec.Properties == nil {
+			continue
+		}
+		var properties *TriggerPropertiesCollection
+		properties, _, err = planner.client.ListTektonPipelineTriggerPropertiesWithContext(planner.ctx, &ListTektonPipelineTriggerPropertiesOptions{
+			PipelineID: planner.options.PipelineID,
+			TriggerID:  existing.ID,
+			Headers:    planner.options.Headers,
+		})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "spec-list-trigger-properties-error")
+			return
+		}
+		var liveProperties []TektonPipelinePropertySpec
+		for _, property := range properties.Properties {
+			liveProperties = append(liveProperties, TektonPipelinePropertySpec{
+				Name:   property.Name,
+				Type:   property.Type,
+				Value:  property.Value,
+				Enum:   property.Enum,
+				Locked: property.Locked,
+				Path:   property.Path,
+			})
+		}
+		// Trigger properties are planned after all the triggers, since the properties of new triggers need their IDs.
+		propertyPlanner := &tektonPipelinePlanner{options: planner.options, plan: &TektonPipelinePlan{}}
+		propertyPlanner.planPropertyList(TektonPipelinePlanResourceTriggerPropertyConst, name, *existing.ID, triggerSpec.Properties, liveProperties)
+		propertyActions = append(propertyActions, propertyPlanner.plan.Actions...)
+		planner.deletes = append(planner.deletes, propertyPlanner.deletes...)
+	}
+	planner.plan.Actions = append(planner.plan.Actions, propertyActions...)
+
+	// The properties of the deleted triggers are deleted with them.
+	for _, triggerIntf := range triggers.Triggers {
+		if trigger, ok := triggerIntf.(*Trigger); ok && live[core.StringNilMapper(trigger.Name)] != nil {
+			planner.addDelete(TektonPipelinePlanAction{Resource: TektonPipelinePlanResourceTriggerConst, Name: *trigger.Name, ID: *trigger.ID})
+		}
+	}
+	return
+}
+
+// planPropertyList plans the pipeline properties, or the properties of the trigger with the specified name and ID.
+func (planner *tektonPipelinePlanner) planPropertyList(resource string, triggerName string, triggerID string, specs []TektonPipelinePropertySpec, live []TektonPipelinePropertySpec) {
+	actionName := func(name string) string {
+		if triggerName == "" {
+			return name
+		}
+		return triggerName + "/" + name
+	}
+	liveByName := make(map[string]*TektonPipelinePropertySpec)
+	for index := range live {
+		liveByName[core.StringNilMapper(live[index].Name)] = &live[index]
+	}
+	for index := range specs {
+		property := &specs[index]
+		existing := liveByName[*property.Name]
+		delete(liveByName, *property.Name)
+		action := TektonPipelinePlanAction{
+			Resource:    resource,
+			Name:        actionName(*property.Name),
+			ID:          triggerID,
+			property:    property,
+			triggerName: triggerName,
+		}
+		if existing == nil {
+			action.Action = TektonPipelinePlanActionCreateConst
+			planner.add(action)
+		} else if action.Changes = propertyChanges(property, existing, planner.options.ReplaceSecureValues); len(action.Changes) > 0 {
+			action.Action = TektonPipelinePlanActionReplaceConst
+			planner.add(action)
+		}
+	}
+	for _, property := range live {
+		if liveByName[core.StringNilMapper(property.Name)] != nil {
+			planner.addDelete(TektonPipelinePlanAction{
+				Resource:    resource,
+				Name:        actionName(*property.Name),
+				ID:          triggerID,
+				property:    &TektonPipelinePropertySpec{Name: property.Name},
+				triggerName: triggerName,
+			})
+		}
+	}
+}
+
+// ApplyTektonPipelinePlanOptions : The ApplyTektonPipelinePlan options.
+type ApplyTektonPipelinePlanOptions struct {
+	// The plan computed by PlanTektonPipelineSpec.
+	Plan *TektonPipelinePlan `json:"plan" validate:"required"`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewApplyTektonPipelinePlanOptions : Instantiate ApplyTektonPipelinePlanOptions
+func (*CdTektonPipelineV2) NewApplyTektonPipelinePlanOptions(plan *TektonPipelinePlan) *ApplyTektonPipelinePlanOptions {
+	return &ApplyTektonPipelinePlanOptions{
+		Plan: plan,
+	}
+}
+
+// SetPlan : Allow user to set Plan
+func (_options *ApplyTektonPipelinePlanOptions) SetPlan(plan *TektonPipelinePlan) *ApplyTektonPipelinePlanOptions {
+	_options.Plan = plan
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ApplyTektonPipelinePlanOptions) SetHeaders(param map[string]string) *ApplyTektonPipelinePlanOptions {
+	options.Headers = param
+	return options
+}
+
+// ApplyTektonPipelinePlan : Apply the changes of a plan to its pipeline
+// The actions are applied in the order of the plan with the Create*, Replace*, Update* and Delete* methods of the
+// resources, and marked as applied. Applying stops at the first error; the actions that were applied before are not
+// rolled back, and planning again afterwards resumes the remaining changes.
+func (cdTektonPipeline *CdTektonPipelineV2) ApplyTektonPipelinePlan(applyTektonPipelinePlanOptions *ApplyTektonPipelinePlanOptions) (result *TektonPipelinePlan, err error) {
+	result, err = cdTektonPipeline.ApplyTektonPipelinePlanWithContext(context.Background(), applyTektonPipelinePlanOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ApplyTektonPipelinePlanWithContext is an alternate form of the ApplyTektonPipelinePlan method which supports a Context parameter.
+func (cdTektonPipeline *CdTektonPipelineV2) ApplyTektonPipelinePlanWithContext(ctx context.Context, applyTektonPipelinePlanOptions *ApplyTektonPipelinePlanOptions) (result *TektonPipelinePlan, err error) {
+	err = core.ValidateNotNil(applyTektonPipelinePlanOptions, "applyTektonPipelinePlanOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(applyTektonPipelinePlanOptions, "applyTektonPipelinePlanOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	result = applyTektonPipelinePlanOptions.Plan
+	// The IDs of the triggers created by the plan, for their properties.
+	triggerIDs := make(map[string]string)
+	for index := range result.Actions {
+		action := &result.Actions[index]
+		if action.Applied {
+			continue
+		}
+		if action.Resource == TektonPipelinePlanResourceTriggerPropertyConst && action.ID == "" {
+			action.ID = triggerIDs[action.triggerName]
+		}
+		var triggerID string
+		triggerID, err = cdTektonPipeline.applyTektonPipelinePlanAction(ctx, result.PipelineID, action, applyTektonPipelinePlanOptions.Headers)
+		if err != nil {
+			err = core.SDKErrorf(err, fmt.Sprintf("failed to %s %s '%s': %s", action.Action, action.Resource, action.Name, err.Error()), "spec-apply-error", common.GetComponentInfo())
+			return
+		}
+		if triggerID != "" {
+			triggerIDs[action.Name] = triggerID
+			action.ID = triggerID
+		}
+		action.Applied = true
+	}
+	return
+}
+
+// applyTektonPipelinePlanAction applies an action, and returns the ID of the trigger it created, if any.
+func (cdTektonPipeline *CdTektonPipelineV2) applyTektonPipelinePlanAction(ctx context.Context, pipelineID string, action *TektonPipelinePlanAction, headers map[string]string) (triggerID string, err error) {
+	pipelineIDPtr := core.StringPtr(pipelineID)
+	switch action.Resource + ":" + action.Action {
+	case TektonPipelinePlanResourcePipelineConst + ":" + TektonPipelinePlanActionUpdateConst:
+		var patch map[string]interface{}
+		patch, err = action.pipelinePatch.AsPatch()
+		if err != nil {
+			err = core.SDKErrorf(err, "", "pipeline-patch-error", common.GetComponentInfo())
+			return
+		}
+		_, _, err = cdTektonPipeline.UpdateTektonPipelineWithContext(ctx, &UpdateTektonPipelineOptions{
+			ID:                  pipelineIDPtr,
+			TektonPipelinePatch: patch,
+			Headers:             headers,
+		})
+
+	case TektonPipelinePlanResourceDefinitionConst + ":" + TektonPipelinePlanActionCreateConst:
+		_, _, err = cdTektonPipeline.CreateTektonPipelineDefinitionWithContext(ctx, &CreateTektonPipelineDefinitionOptions{
+			PipelineID: pipelineIDPtr,
+			Source:     action.definition,
+			Headers:    headers,
+		})
+	case TektonPipelinePlanResourceDefinitionConst + ":" + TektonPipelinePlanActionReplaceConst:
+		_, _, err = cdTektonPipeline.ReplaceTektonPipelineDefinitionWithContext(ctx, &ReplaceTektonPipelineDefinitionOptions{
+			PipelineID:   pipelineIDPtr,
+			DefinitionID: core.StringPtr(action.ID),
+			Source:       action.definition,
+			Headers:      headers,
+		})
+	case TektonPipelinePlanResourceDefinitionConst + ":" + TektonPipelinePlanActionDeleteConst:
+		_, err = cdTektonPipeline.DeleteTektonPipelineDefinitionWithContext(ctx, &DeleteTektonPipelineDefinitionOptions{
+			PipelineID:   pipelineIDPtr,
+			DefinitionID: core.StringPtr(action.ID),
+			Headers:      headers,
+		})
+
+	case TektonPipelinePlanResourcePropertyConst + ":" + TektonPipelinePlanActionCreateConst:
+		_, _, err = cdTektonPipeline.CreateTektonPipelinePropertiesWithContext(ctx, &CreateTektonPipelinePropertiesOptions{
+			PipelineID: pipelineIDPtr,
+			Name:       action.property.Name,
+			Type:       action.property.Type,
+			Value:      action.property.Value,
+			Enum:       action.property.Enum,
+			Locked:     action.property.Locked,
+			Path:       action.property.Path,
+			Headers:    headers,
+		})
+	case TektonPipelinePlanResourcePropertyConst + ":" + TektonPipelinePlanActionReplaceConst:
+		_, _, err = cdTektonPipeline.ReplaceTektonPipelinePropertyWithContext(ctx, &ReplaceTektonPipelinePropertyOptions{
+			PipelineID:   pipelineIDPtr,
+			PropertyName: action.property.Name,
+			Name:         action.property.Name,
+			Type:         action.property.Type,
+			Value:        action.property.Value,
+			Enum:         action.property.Enum,
+			Locked:       action.property.Locked,
+			Path:         action.property.Path,
+			Headers:      headers,
+		})
+	case TektonPipelinePlanResourcePropertyConst + ":" + TektonPipelinePlanActionDeleteConst:
+		_, err = cdTektonPipeline.DeleteTektonPipelinePropertyWithContext(ctx, &DeleteTektonPipelinePropertyOptions{
+			PipelineID:   pipelineIDPtr,
+			PropertyName: action.property.Name,
+			Headers:      headers,
+		})
+
+	case TektonPipelinePlanResourceTriggerConst + ":" + TektonPipelinePlanActionCreateConst:
+		trigger := action.trigger
+		var created TriggerIntf
+		created, _, err = cdTektonPipeline.CreateTektonPipelineTriggerWithContext(ctx, &CreateTektonPipelineTriggerOptions{
+			PipelineID:            pipelineIDPtr,
+			Type:                  trigger.Type,
+			Name:                  trigger.Name,
+			EventListener:         trigger.EventListener,
+			Tags:                  trigger.Tags,
+			Worker:                trigger.Worker,
+			MaxConcurrentRuns:     trigger.MaxConcurrentRuns,
+			LimitWaitingRuns:      trigger.LimitWaitingRuns,
+			Enabled:               trigger.Enabled,
+			Secret:                trigger.Secret,
+			Cron:                  trigger.Cron,
+			Timezone:              trigger.Timezone,
+			Source:                trigger.Source,
+			Events:                trigger.Events,
+			Filter:                trigger.Filter,
+			Favorite:              trigger.Favorite,
+			EnableEventsFromForks: trigger.EnableEventsFromForks,
+			DisableDraftEvents:    trigger.DisableDraftEvents,
+			Headers:               headers,
+		})
+		if createdTrigger, ok := created.(*Trigger); ok && createdTrigger.ID != nil {
+			triggerID = *createdTrigger.ID
+		}
+	case TektonPipelinePlanResourceTriggerConst + ":" + TektonPipelinePlanActionUpdateConst:
+		trigger := action.trigger
+		secret := trigger.Secret
+		if secret != nil && isSecurePlaceholder(secret.Value) {
+			secret = &GenericSecret{Type: secret.Type, Source: secret.Source, KeyName: secret.KeyName, Algorithm: secret.Algorithm}
+		}
+		var patch map[string]interface{}
+		patch, err = (&TriggerPatch{
+			Type:                  trigger.Type,
+			EventListener:         trigger.EventListener,
+			Tags:                  trigger.Tags,
+			Worker:                trigger.Worker,
+			MaxConcurrentRuns:     trigger.MaxConcurrentRuns,
+			LimitWaitingRuns:      trigger.LimitWaitingRuns,
+			Enabled:               trigger.Enabled,
+			Secret:                secret,
+			Cron:                  trigger.Cron,
+			Timezone:              trigger.Timezone,
+			Source:                trigger.Source,
+			Events:                trigger.Events,
+			Filter:                trigger.Filter,
+			Favorite:              trigger.Favorite,
+			EnableEventsFromForks: trigger.EnableEventsFromForks,
+			DisableDraftEvents:    trigger.DisableDraftEvents,
+		}).AsPatch()
+		if err != nil {
+			err = core.SDKErrorf(err, "", "trigger-patch-error", common.GetComponentInfo())
+			return
+		}
+		_, _, err = cdTektonPipeline.UpdateTektonPipelineTriggerWithContext(ctx, &UpdateTektonPipelineTriggerOptions{
+			PipelineID:   pipelineIDPtr,
+			TriggerID:    core.StringPtr(action.ID),
+			TriggerPatch: patch,
+			Headers:      headers,
+		})
+	case TektonPipelinePlanResourceTriggerConst + ":" + TektonPipelinePlanActionDeleteConst:
+		_, err = cdTektonPipeline.DeleteTektonPipelineTriggerWithContext(ctx, &DeleteTektonPipelineTriggerOptions{
+			PipelineID: pipelineIDPtr,
+			TriggerID:  core.StringPtr(action.ID),
+			Headers:    headers,
+		})
+
+	case TektonPipelinePlanResourceTriggerPropertyConst + ":" + TektonPipelinePlanActionCreateConst:
+		_, _, err = cdTektonPipeline.CreateTektonPipelineTriggerPropertiesWithContext(ctx, &CreateTektonPipelineTriggerPropertiesOptions{
+			PipelineID: pipelineIDPtr,
+			TriggerID:  core.StringPtr(action.ID),
+			Name:       action.property.Name,
+			Type:       action.property.Type,
+			Value:      action.property.Value,
+			Enum:       action.property.Enum,
+			Path:       action.property.Path,
+			Locked:     action.property.Locked,
+			Headers:    headers,
+		})
+	case TektonPipelinePlanResourceTriggerPropertyConst + ":" + TektonPipelinePlanActionReplaceConst:
+		_, _, err = cdTektonPipeline.ReplaceTektonPipelineTriggerPropertyWithContext(ctx, &ReplaceTektonPipelineTriggerPropertyOptions{
+			PipelineID:   pipelineIDPtr,
+			TriggerID:    core.StringPtr(action.ID),
+			PropertyName: action.property.Name,
+			Name:         action.property.Name,
+			Type:         action.property.Type,
+			Value:        action.property.Value,
+			Enum:         action.property.Enum,
+			Path:         action.property.Path,
+			Locked:       action.property.Locked,
+			Headers:      headers,
+		})
+	case TektonPipelinePlanResourceTriggerPropertyConst + ":" + TektonPipelinePlanActionDeleteConst:
+		_, err = cdTektonPipeline.DeleteTektonPipelineTriggerPropertyWithContext(ctx, &DeleteTektonPipelineTriggerPropertyOptions{
+			PipelineID:   pipelineIDPtr,
+			TriggerID:    core.StringPtr(action.ID),
+			PropertyName: action.property.Name,
+			Headers:      headers,
+		})
+
+	default:
+		err = core.SDKErrorf(nil, "the action is not part of a plan computed by PlanTektonPipelineSpec", "invalid-plan-action", common.GetComponentInfo())
+	}
+	return
+}
+
+// definitionSpecName returns the name of a definition in plans: its repository URL and path.
+func definitionSpecName(source *DefinitionSource) string {
+	if source == nil || source.Properties == nil {
+		return ""
+	}
+	return strings.TrimSuffix(core.StringNilMapper(source.Properties.URL), "/") + "/" + strings.TrimPrefix(core.StringNilMapper(source.Properties.Path), "/")
+}
+
+// definitionChanges returns the fields of a definition that differ from the spec.
+func definitionChanges(spec *DefinitionSource, live *DefinitionSource) (changes []string) {
+	liveProperties := live.Properties
+	if liveProperties == nil {
+		liveProperties = &DefinitionSourceProperties{}
+	}
+	if !specStringEqual(spec.Type, live.Type) {
+		changes = append(changes, "type")
+	}
+	if !specStringEqual(spec.Properties.Branch, liveProperties.Branch) {
+		changes = append(changes, "branch")
+	}
+	if !specStringEqual(spec.Properties.Tag, liveProperties.Tag) {
+		changes = append(changes, "tag")
+	}
+	if spec.Properties.Tool != nil && (liveProperties.Tool == nil || !specStringEqual(spec.Properties.Tool.ID, liveProperties.Tool.ID)) {
+		changes = append(changes, "tool")
+	}
+	return
+}
+
+// propertyChanges returns the fields of a property that differ from the spec.
+func propertyChanges(spec *TektonPipelinePropertySpec, live *TektonPipelinePropertySpec, replaceSecureValues bool) (changes []string) {
+	if !specStringEqual(spec.Type, live.Type) {
+		changes = append(changes, "type")
+	}
+	if *spec.Type == PropertyTypeSecureConst && specStringEqual(spec.Type, live.Type) {
+		if replaceSecureValues && spec.Value != nil && !isSecurePlaceholder(spec.Value) {
+			changes = append(changes, "value")
+		}
+	} else if !specStringEqual(spec.Value, live.Value) {
+		changes = append(changes, "value")
+	}
+	if !slices.Equal(spec.Enum, live.Enum) {
+		changes = append(changes, "enum")
+	}
+	if !specBoolEqual(spec.Locked, live.Locked) {
+		changes = append(changes, "locked")
+	}
+	if !specStringEqual(spec.Path, live.Path) {
+		changes = append(changes, "path")
+	}
+	return
+}
+
+// triggerChanges returns the fields of a trigger that are set in the spec and differ from the trigger.
+func triggerChanges(spec *TektonPipelineTriggerSpec, live *Trigger, replaceSecureValues bool) (changes []string) {
+	changed := func(field string, differs bool) {
+		if differs {
+			changes = append(changes, field)
+		}
+	}
+	changed("type", !specStringEqual(spec.Type, live.Type))
+	changed("event_listener", !specStringEqual(spec.EventListener, live.EventListener))
+	changed("tags", spec.Tags != nil && !specStringSetEqual(spec.Tags, live.Tags))
+	changed("worker", spec.Worker != nil && (live.Worker == nil || !specStringEqual(spec.Worker.ID, live.Worker.ID)))
+	changed("max_concurrent_runs", spec.MaxConcurrentRuns != nil && (live.MaxConcurrentRuns == nil || *spec.MaxConcurrentRuns != *live.MaxConcurrentRuns))
+	changed("limit_waiting_runs", spec.LimitWaitingRuns != nil && !specBoolEqual(spec.LimitWaitingRuns, live.LimitWaitingRuns))
+	changed("enabled", spec.Enabled != nil && !specBoolEqual(spec.Enabled, live.Enabled))
+	if spec.Secret != nil {
+		liveSecret := live.Secret
+		if liveSecret == nil {
+			liveSecret = &GenericSecret{}
+		}
+		changed("secret", !specStringEqual(spec.Secret.Type, liveSecret.Type) ||
+			!specStringEqual(spec.Secret.Source, liveSecret.Source) ||
+			!specStringEqual(spec.Secret.KeyName, liveSecret.KeyName) ||
+			!specStringEqual(spec.Secret.Algorithm, liveSecret.Algorithm) ||
+			(replaceSecureValues && spec.Secret.Value != nil && !isSecurePlaceholder(spec.Secret.Value)))
+	}
+	changed("cron", spec.Cron != nil && !specStringEqual(spec.Cron, live.Cron))
+	changed("timezone", spec.Timezone != nil && !specStringEqual(spec.Timezone, live.Timezone))
+	if spec.Source != nil {
+		differs := live.Source == nil || live.Source.Properties == nil || !specStringEqual(spec.Source.Type, live.Source.Type)
+		if !differs && spec.Source.Properties != nil {
+			differs = !specStringEqual(spec.Source.Properties.URL, live.Source.Properties.URL) ||
+				!specStringEqual(spec.Source.Properties.Branch, live.Source.Properties.Branch) ||
+				!specStringEqual(spec.Source.Properties.Pattern, live.Source.Properties.Pattern)
+		}
+		changed("source", differs)
+	}
+	changed("events", spec.Events != nil && !specStringSetEqual(spec.Events, live.Events))
+	changed("filter", spec.Filter != nil && !specStringEqual(spec.Filter, live.Filter))
+	changed("favorite", spec.Favorite != nil && !specBoolEqual(spec.Favorite, live.Favorite))
+	changed("enable_events_from_forks", spec.EnableEventsFromForks != nil && !specBoolEqual(spec.EnableEventsFromForks, live.EnableEventsFromForks))
+	changed("disable_draft_events", spec.DisableDraftEvents != nil && !specBoolEqual(spec.DisableDraftEvents, live.DisableDraftEvents))
+	return
+}
+
+// specStringEqual compares optional strings, treating nil as empty.
+func specStringEqual(a *string, b *string) bool {
+	return core.StringNilMapper(a) == core.StringNilMapper(b)
+}
+
+// specStringSetEqual compares lists of strings regardless of their order.
+func specStringSetEqual(a []string, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
+
+// specBoolEqual compares optional booleans, treating nil as false.
+func specBoolEqual(a *bool, b *bool) bool {
+	return (a != nil && *a) == (b != nil && *b)
+}
@@ -0,0 +1,341 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"sigs.k8s.io/yaml"
+)
+
+// exportToolNameInvalidChars matches the characters that are replaced in the symbolic tool names derived from
+// repository URLs.
+var exportToolNameInvalidChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// ExportTektonPipelineSpecOptions : The ExportTektonPipelineSpec options.
+type ExportTektonPipelineSpecOptions struct {
+	// The Tekton pipeline ID.
+	PipelineID *string `json:"pipeline_id" validate:"required,ne="`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewExportTektonPipelineSpecOptions : Instantiate ExportTektonPipelineSpecOptions
+func (*CdTektonPipelineV2) NewExportTektonPipelineSpecOptions(pipelineID string) *ExportTektonPipelineSpecOptions {
+	return &ExportTektonPipelineSpecOptions{
+		PipelineID: core.StringPtr(pipelineID),
+	}
+}
+
+// SetPipelineID : Allow user to set PipelineID
+func (_options *ExportTektonPipelineSpecOptions) SetPipelineID(pipelineID string) *ExportTektonPipelineSpecOptions {
+	_options.PipelineID = core.StringPtr(pipelineID)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *ExportTektonPipelineSpecOptions) SetHeaders(param map[string]string) *ExportTektonPipelineSpecOptions {
+	options.Headers = param
+	return options
+}
+
+// ExportTektonPipelineSpec : Export the configuration of a pipeline as a spec
+// This reads the pipeline, its definitions, properties, triggers and trigger properties, and returns them as a
+// TektonPipelineSpec that PlanTektonPipelineSpec finds up to date. The spec is normalized so that exports of the same
+// configuration are identical:
+//   - resources are sorted by name, and trigger tags and events alphabetically;
+//   - fields managed by the service, such as `href`, `hook_id`, `webhook_url` and timestamps, are left out;
+//   - secure property values and trigger secrets are replaced by TektonPipelineSpecSecurePlaceholder;
+//   - the repository tools of definitions are referenced by symbolic names, derived from the repository URLs and
+//     mapped to the tool IDs in the `tools` field.
+func (cdTektonPipeline *CdTektonPipelineV2) ExportTektonPipelineSpec(exportTektonPipelineSpecOptions *ExportTektonPipelineSpecOptions) (result *TektonPipelineSpec, err error) {
+	result, err = cdTektonPipeline.ExportTektonPipelineSpecWithContext(context.Background(), exportTektonPipelineSpecOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// ExportTektonPipelineSpecWithContext is an alternate form of the ExportTektonPipelineSpec method which supports a Context parameter.
+func (cdTektonPipeline *CdTektonPipelineV2) ExportTektonPipelineSpecWithContext(ctx context.Context, exportTektonPipelineSpecOptions *ExportTektonPipelineSpecOptions) (result *TektonPipelineSpec, err error) {
+	err = core.ValidateNotNil(exportTektonPipelineSpecOptions, "exportTektonPipelineSpecOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(exportTektonPipelineSpecOptions, "exportTektonPipelineSpecOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	pipelineID := exportTektonPipelineSpecOptions.PipelineID
+	headers := exportTektonPipelineSpecOptions.Headers
+
+	pipeline, _, err := cdTektonPipeline.GetTektonPipelineWithContext(ctx, &GetTektonPipelineOptions{
+		ID:      pipelineID,
+		Headers: headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "spec-get-pipeline-error")
+		return
+	}
+	spec := &TektonPipelineSpec{
+		EnableNotifications:  pipeline.EnableNotifications,
+		EnablePartialCloning: pipeline.EnablePartialCloning,
+		Definitions:          []TektonPipelineDefinitionSpec{},
+		Properties:           []TektonPipelinePropertySpec{},
+		Triggers:             []TektonPipelineTriggerSpec{},
+	}
+	if pipeline.Worker != nil {
+		spec.Worker = &WorkerIdentity{ID: pipeline.Worker.ID}
+	}
+
+	definitions, _, err := cdTektonPipeline.ListTektonPipelineDefinitionsWithContext(ctx, &ListTektonPipelineDefinitionsOptions{
+		PipelineID: pipelineID,
+		Headers:    headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "spec-list-definitions-error")
+		return
+	}
+	for _, definition := range definitions.Definitions {
+		if definition.Source == nil || definition.Source.Properties == nil {
+			continue
+		}
+		properties := definition.Source.Properties
+		spec.Definitions = append(spec.Definitions, TektonPipelineDefinitionSpec{
+			Source: &DefinitionSource{
+				Type: definition.Source.Type,
+				Properties: &DefinitionSourceProperties{
+					URL:    properties.URL,
+					Branch: exportString(properties.Branch),
+					Tag:    exportString(properties.Tag),
+					Path:   properties.Path,
+					Tool:   properties.Tool,
+				},
+			},
+		})
+	}
+	slices.SortStableFunc(spec.Definitions, func(a TektonPipelineDefinitionSpec, b TektonPipelineDefinitionSpec) int {
+		return strings.Compare(definitionSpecName(a.Source), definitionSpecName(b.Source))
+	})
+	spec.nameTools()
+
+	properties, _, err := cdTektonPipeline.ListTektonPipelinePropertiesWithContext(ctx, &ListTektonPipelinePropertiesOptions{
+		PipelineID: pipelineID,
+		Headers:    headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "spec-list-properties-error")
+		return
+	}
+	for _, property := range properties.Properties {
+		spec.Properties = append(spec.Properties, exportProperty(property.Name, property.Type, property.Value, property.Enum, property.Locked, property.Path))
+	}
+	sortPropertySpecs(spec.Properties)
+
+	triggers, _, err := cdTektonPipeline.ListTektonPipelineTriggersWithContext(ctx, &ListTektonPipelineTriggersOptions{
+		PipelineID: pipelineID,
+		Headers:    headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "spec-list-triggers-error")
+		return
+	}
+	for _, triggerIntf := range triggers.Triggers {
+		trigger, ok := triggerIntf.(*Trigger)
+		if !ok {
+			continue
+		}
+		var triggerProperties *TriggerPropertiesCollection
+		triggerProperties, _, err = cdTektonPipeline.ListTektonPipelineTriggerPropertiesWithContext(ctx, &ListTektonPipelineTriggerPropertiesOptions{
+			PipelineID: pipelineID,
+			TriggerID:  trigger.ID,
+			Headers:    headers,
+		})
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "spec-list-trigger-properties-error")
+			return
+		}
+		spec.Triggers = append(spec.Triggers, exportTrigger(trigger, triggerProperties.Properties))
+	}
+	slices.SortStableFunc(spec.Triggers, func(a TektonPipelineTriggerSpec, b TektonPipelineTriggerSpec) int {
+		return strings.Compare(*a.Name, *b.Name)
+	})
+
+	result = spec
+	return
+}
+
+// YAML returns the spec as a YAML document. Object fields are sorted alphabetically.
+func (spec *TektonPipelineSpec) YAML() ([]byte, error) {
+	data, err := yaml.Marshal(spec)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "spec-marshal-error", common.GetComponentInfo())
+	}
+	return data, nil
+}
+
+// Save writes the spec to a YAML file. The file is replaced atomically, so that it is never left half written.
+func (spec *TektonPipelineSpec) Save(path string) error {
+	data, err := spec.YAML()
+	if err != nil {
+		return err
+	}
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return core.SDKErrorf(err, "", "spec-save-error", common.GetComponentInfo())
+	}
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), path)
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return core.SDKErrorf(err, "", "spec-save-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// nameTools replaces the tool IDs of the definitions with symbolic names, derived from the last segment of their
+// repository URL, and records the IDs in Tools.
+func (spec *TektonPipelineSpec) nameTools() {
+	names := make(map[string]string)
+	for _, definition := range spec.Definitions {
+		properties := definition.Source.Properties
+		if properties.Tool == nil || properties.Tool.ID == nil {
+			continue
+		}
+		toolID := *properties.Tool.ID
+		name, found := names[toolID]
+		if !found {
+			base := strings.TrimSuffix(strings.TrimSuffix(core.StringNilMapper(properties.URL), "/"), ".git")
+			base = exportToolNameInvalidChars.ReplaceAllString(strings.ToLower(base[strings.LastIndex(base, "/")+1:]), "-")
+			base = strings.Trim(base, "-")
+			if base == "" {
+				base = "repository"
+			}
+			name = base
+			for suffix := 2; spec.Tools[name] != ""; suffix++ {
+				name = fmt.Sprintf("%s-%d", base, suffix)
+			}
+			if spec.Tools == nil {
+				spec.Tools = make(map[string]string)
+			}
+			spec.Tools[name] = toolID
+			names[toolID] = name
+		}
+		properties.Tool = &Tool{ID: core.StringPtr(name)}
+	}
+}
+
+// exportTrigger returns the spec of a trigger and its properties.
+func exportTrigger(trigger *Trigger, properties []TriggerProperty) TektonPipelineTriggerSpec {
+	triggerSpec := TektonPipelineTriggerSpec{
+		Type:                  trigger.Type,
+		Name:                  trigger.Name,
+		EventListener:         trigger.EventListener,
+		MaxConcurrentRuns:     trigger.MaxConcurrentRuns,
+		LimitWaitingRuns:      trigger.LimitWaitingRuns,
+		Enabled:               trigger.Enabled,
+		Cron:                  exportString(trigger.Cron),
+		Timezone:              exportString(trigger.Timezone),
+		Filter:                exportString(trigger.Filter),
+		Favorite:              trigger.Favorite,
+		EnableEventsFromForks: trigger.EnableEventsFromForks,
+		DisableDraftEvents:    trigger.DisableDraftEvents,
+		Properties:            []TektonPipelinePropertySpec{},
+	}
+	if len(trigger.Tags) > 0 {
+		triggerSpec.Tags = slices.Sorted(slices.Values(trigger.Tags))
+	}
+	if len(trigger.Events) > 0 {
+		triggerSpec.Events = slices.Sorted(slices.Values(trigger.Events))
+	}
+	if trigger.Worker != nil {
+		triggerSpec.Worker = &WorkerIdentity{ID: trigger.Worker.ID}
+	}
+	if trigger.Secret != nil {
+		triggerSpec.Secret = &GenericSecret{
+			Type:      trigger.Secret.Type,
+			Source:    trigger.Secret.Source,
+			KeyName:   exportString(trigger.Secret.KeyName),
+			Algorithm: trigger.Secret.Algorithm,
+		}
+		if trigger.Secret.Value != nil {
+			triggerSpec.Secret.Value = core.StringPtr(TektonPipelineSpecSecurePlaceholder)
+		}
+	}
+	if trigger.Source != nil && trigger.Source.Properties != nil {
+		triggerSpec.Source = &TriggerSourcePrototype{
+			Type: trigger.Source.Type,
+			Properties: &TriggerSourcePropertiesPrototype{
+				URL:     trigger.Source.Properties.URL,
+				Branch:  exportString(trigger.Source.Properties.Branch),
+				Pattern: exportString(trigger.Source.Properties.Pattern),
+			},
+		}
+	}
+	for _, property := range properties {
+		triggerSpec.Properties = append(triggerSpec.Properties, exportProperty(property.Name, property.Type, property.Value, property.Enum, property.Locked, property.Path))
+	}
+	sortPropertySpecs(triggerSpec.Properties)
+	return triggerSpec
+}
+
+// exportProperty returns the spec of a pipeline or trigger property.
+func exportProperty(name *string, typeVar *string, value *string, enum []string, locked *bool, path *string) TektonPipelinePropertySpec {
+	property := TektonPipelinePropertySpec{
+		Name:  name,
+		Type:  typeVar,
+		Value: value,
+		Enum:  enum,
+		Path:  exportString(path),
+	}
+	if locked != nil && *locked {
+		property.Locked = locked
+	}
+	if core.StringNilMapper(typeVar) == PropertyTypeSecureConst {
+		property.Value = core.StringPtr(TektonPipelineSpecSecurePlaceholder)
+	}
+	return property
+}
+
+// sortPropertySpecs sorts properties by name.
+func sortPropertySpecs(properties []TektonPipelinePropertySpec) {
+	slices.SortStableFunc(properties, func(a TektonPipelinePropertySpec, b TektonPipelinePropertySpec) int {
+		return strings.Compare(core.StringNilMapper(a.Name), core.StringNilMapper(b.Name))
+	})
+}
+
+// exportString returns nil for empty strings, which the service returns for some unset fields.
+func exportString(value *string) *string {
+	if value == nil || *value == "" {
+		return nil
+	}
+	return value
+}
@@ -0,0 +1,236 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"net/http/httptest"
+	"path/filepath"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CdTektonPipelineV2 pipeline spec export`, func() {
+	var config *mockPipelineConfig
+	var testServer *httptest.Server
+	var cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+
+	BeforeEach(func() {
+		config = newMockPipelineConfig("PipelineID")
+		config.pipeline["worker"] = map[string]interface{}{"id": "public", "name": "IBM Managed workers", "type": "public"}
+		config.pipeline["enable_notifications"] = true
+		config.pipeline["enable_partial_cloning"] = false
+		config.pipeline["created_at"] = "2026-01-01T00:00:00.000Z"
+		config.pipeline["href"] = "https://api/tekton_pipelines/PipelineID"
+		source := func(url string, path string, toolID string) map[string]interface{} {
+			return map[string]interface{}{"type": "git", "properties": map[string]interface{}{
+				"url": url, "branch": "main", "tag": "", "path": path, "tool": map[string]interface{}{"id": toolID},
+			}}
+		}
+		config.definitions = []map[string]interface{}{
+			{"id": "def-1", "href": "https://api/definitions/def-1", "source": source("https://github.com/org/tasks.git", "tasks", "tool-2")},
+			{"id": "def-2", "source": source("https://github.com/org/App", ".tekton", "tool-1")},
+			{"id": "def-3", "source": source("https://github.com/other/App", ".tekton", "tool-3")},
+			{"id": "def-4", "source": source("https://github.com/org/App", "extra", "tool-1")},
+		}
+		config.properties = []map[string]interface{}{
+			{"name": "region", "type": "text", "value": "us-south", "href": "https://api/properties/region"},
+			{"name": "api-key", "type": "secure", "value": "hash", "locked": true},
+			{"name": "env", "type": "single_select", "value": "dev", "enum": []string{"prod", "dev"}, "locked": false},
+		}
+		config.triggers = []map[string]interface{}{
+			{
+				"id": "trigger-1", "name": "on-push", "type": "scm", "event_listener": "listener", "enabled": true,
+				"tags": []string{"push", "ci"}, "events": []string{"push", "pull_request"}, "max_concurrent_runs": 2,
+				"href": "https://api/triggers/trigger-1", "worker": map[string]interface{}{"id": "private", "name": "mine"},
+				"source": map[string]interface{}{"type": "git", "properties": map[string]interface{}{
+					"url": "https://github.com/org/App", "branch": "main", "blind_connection": false,
+					"hook_id": "42", "tool": map[string]interface{}{"id": "tool-1"},
+				}},
+			},
+			{
+				"id": "trigger-2", "name": "hook", "type": "generic", "event_listener": "listener", "enabled": false,
+				"webhook_url": "https://api/webhook", "filter": "",
+				"secret": map[string]interface{}{"type": "token_matches", "value": "hash", "source": "header", "key_name": "token"},
+			},
+		}
+		config.triggerProperties["trigger-1"] = []map[string]interface{}{
+			{"name": "token", "type": "secure", "value": "hash"},
+			{"name": "branch", "type": "text", "value": "main", "href": "https://api/triggers/trigger-1/properties/branch"},
+		}
+		testServer = config.server()
+		var serviceErr error
+		cdTektonPipelineService, serviceErr = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Invoke ExportTektonPipelineSpec successfully`, func() {
+		spec, err := cdTektonPipelineService.ExportTektonPipelineSpec(cdTektonPipelineService.NewExportTektonPipelineSpecOptions("PipelineID"))
+		Expect(err).To(BeNil())
+		Expect(config.requestLog()).To(Equal([]string{
+			"GET /tekton_pipelines/PipelineID",
+			"GET /tekton_pipelines/PipelineID/definitions",
+			"GET /tekton_pipelines/PipelineID/properties",
+			"GET /tekton_pipelines/PipelineID/triggers",
+			"GET /tekton_pipelines/PipelineID/triggers/trigger-1/properties",
+			"GET /tekton_pipelines/PipelineID/triggers/trigger-2/properties",
+		}))
+
+		data, err := spec.YAML()
+		Expect(err).To(BeNil())
+		Expect(string(data)).To(Equal(`definitions:
+- source:
+    properties:
+      branch: main
+      path: .tekton
+      tool:
+        id: app
+      url: https://github.com/org/App
+    type: git
+- source:
+    properties:
+      branch: main
+      path: extra
+      tool:
+        id: app
+      url: https://github.com/org/App
+    type: git
+- source:
+    properties:
+      branch: main
+      path: tasks
+      tool:
+        id: tasks
+      url: https://github.com/org/tasks.git
+    type: git
+- source:
+    properties:
+      branch: main
+      path: .tekton
+      tool:
+        id: app-2
+      url: https://github.com/other/App
+    type: git
+enable_notifications: true
+enable_partial_cloning: false
+properties:
+- locked: true
+  name: api-key
+  type: secure
+  value: <secure>
+- enum:
+  - prod
+  - dev
+  name: env
+  type: single_select
+  value: dev
+- name: region
+  type: text
+  value: us-south
+tools:
+  app: tool-1
+  app-2: tool-3
+  tasks: tool-2
+triggers:
+- enabled: false
+  event_listener: listener
+  name: hook
+  properties: []
+  secret:
+    key_name: token
+    source: header
+    type: token_matches
+    value: <secure>
+  type: generic
+- enabled: true
+  event_listener: listener
+  events:
+  - pull_request
+  - push
+  max_concurrent_runs: 2
+  name: on-push
+  properties:
+  - name: branch
+    type: text
+    value: main
+  - name: token
+    type: secure
+    value: <secure>
+  source:
+    properties:
+      branch: main
+      url: https://github.com/org/App
+    type: git
+  tags:
+  - ci
+  - push
+  type: scm
+  worker:
+    id: private
+worker:
+  id: public
+`))
+
+		specFile := filepath.Join(GinkgoT().TempDir(), "pipeline.yaml")
+		Expect(spec.Save(specFile)).To(Succeed())
+		loaded, err := cdtektonpipelinev2.LoadTektonPipelineSpec(specFile)
+		Expect(err).To(BeNil())
+		Expect(loaded).To(Equal(spec))
+
+		plan, err := cdTektonPipelineService.PlanTektonPipelineSpec(cdTektonPipelineService.NewPlanTektonPipelineSpecOptions("PipelineID", loaded))
+		Expect(err).To(BeNil())
+		Expect(plan.IsEmpty()).To(BeTrue())
+	})
+	It(`Invoke PlanTektonPipelineSpec with an exported spec on another pipeline`, func() {
+		spec, err := cdTektonPipelineService.ExportTektonPipelineSpec(cdTektonPipelineService.NewExportTektonPipelineSpecOptions("PipelineID"))
+		Expect(err).To(BeNil())
+
+		config.definitions = nil
+		config.properties = nil
+		config.triggers = nil
+		spec.Tools["app"] = "tool-9"
+		spec.Triggers = nil
+		plan, err := cdTektonPipelineService.PlanTektonPipelineSpec(cdTektonPipelineService.NewPlanTektonPipelineSpecOptions("PipelineID", spec))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("cannot create property 'api-key' with the placeholder of a secure value"))
+
+		spec.Properties[0].Value = core.StringPtr("secret")
+		plan, err = cdTektonPipelineService.PlanTektonPipelineSpec(cdTektonPipelineService.NewPlanTektonPipelineSpecOptions("PipelineID", spec))
+		Expect(err).To(BeNil())
+		_, err = cdTektonPipelineService.ApplyTektonPipelinePlan(cdTektonPipelineService.NewApplyTektonPipelinePlanOptions(plan))
+		Expect(err).To(BeNil())
+		Expect(config.definitions[0]["source"]).To(HaveKeyWithValue("properties", HaveKeyWithValue("tool", map[string]interface{}{"id": "tool-9"})))
+		Expect(config.properties[0]).To(HaveKeyWithValue("value", "secret"))
+	})
+	It(`Invoke ExportTektonPipelineSpec with error: Operation validation and request error`, func() {
+		_, err := cdTektonPipelineService.ExportTektonPipelineSpec(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.ExportTektonPipelineSpec(cdTektonPipelineService.NewExportTektonPipelineSpecOptions(""))
+		Expect(err).ToNot(BeNil())
+		config.failing["GET /tekton_pipelines/PipelineID/triggers/trigger-2/properties"] = true
+		_, err = cdTektonPipelineService.ExportTektonPipelineSpec(cdTektonPipelineService.NewExportTektonPipelineSpecOptions("PipelineID"))
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,399 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// mockPipelineConfig is an in-memory Tekton pipeline configuration served over HTTP: the pipeline, its definitions,
+// properties, triggers and trigger properties.
+type mockPipelineConfig struct {
+	mutex             sync.Mutex
+	pipelineID        string
+	pipeline          map[string]interface{}
+	definitions       []map[string]interface{}
+	properties        []map[string]interface{}
+	triggers          []map[string]interface{}
+	triggerProperties map[string][]map[string]interface{}
+	requests          []string
+	// Requests, as "METHOD path", that fail with a 500 error.
+	failing map[string]bool
+	nextID  int
+}
+
+func newMockPipelineConfig(pipelineID string) *mockPipelineConfig {
+	return &mockPipelineConfig{
+		pipelineID:        pipelineID,
+		pipeline:          map[string]interface{}{"id": pipelineID, "name": "pipeline", "status": "configured"},
+		triggerProperties: map[string][]map[string]interface{}{},
+		failing:           map[string]bool{},
+	}
+}
+
+func (config *mockPipelineConfig) requestLog() []string {
+	config.mutex.Lock()
+	defer config.mutex.Unlock()
+	return slices.Clone(config.requests)
+}
+
+func (config *mockPipelineConfig) server() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		defer GinkgoRecover()
+		config.mutex.Lock()
+		defer config.mutex.Unlock()
+
+		request := req.Method + " " + req.URL.Path
+		config.requests = append(config.requests, request)
+		prefix := "/tekton_pipelines/" + config.pipelineID
+		if config.failing[request] {
+			res.WriteHeader(500)
+			return
+		}
+		if !strings.HasPrefix(req.URL.Path, prefix) {
+			res.WriteHeader(404)
+			return
+		}
+		var body map[string]interface{}
+		if req.Method == "POST" || req.Method == "PUT" || req.Method == "PATCH" {
+			Expect(json.NewDecoder(req.Body).Decode(&body)).To(Succeed())
+		}
+		write := func(status int, result interface{}) {
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(status)
+			if result != nil {
+				Expect(json.NewEncoder(res).Encode(result)).To(Succeed())
+			}
+		}
+		newID := func() string {
+			config.nextID++
+			return fmt.Sprintf("new-%d", config.nextID)
+		}
+
+		segments := strings.Split(strings.Trim(strings.TrimPrefix(req.URL.Path, prefix), "/"), "/")
+		var list *[]map[string]interface{}
+		key := "name"
+		switch {
+		case segments[0] == "":
+			if req.Method == "PATCH" {
+				maps.Copy(config.pipeline, body)
+			}
+			write(200, config.pipeline)
+			return
+		case segments[0] == "definitions":
+			list, key = &config.definitions, "id"
+		case segments[0] == "properties":
+			list = &config.properties
+		case segments[0] == "triggers" && len(segments) <= 2:
+			list, key = &config.triggers, "id"
+		case segments[0] == "triggers":
+			triggerID := segments[1]
+			properties := config.triggerProperties[triggerID]
+			list, segments = &properties, segments[2:]
+			defer func() { config.triggerProperties[triggerID] = properties }()
+		}
+
+		if len(segments) == 1 {
+			switch req.Method {
+			case "GET":
+				write(200, map[string]interface{}{segments[0]: *list})
+			case "POST":
+				if key == "id" {
+					body["id"] = newID()
+				}
+				*list = append(*list, body)
+				write(201, body)
+			}
+			return
+		}
+		index := slices.IndexFunc(*list, func(item map[string]interface{}) bool { return item[key] == segments[1] })
+		if index < 0 {
+			write(404, nil)
+			return
+		}
+		switch req.Method {
+		case "GET":
+			write(200, (*list)[index])
+		case "PUT":
+			body[key] = segments[1]
+			(*list)[index] = body
+			write(200, body)
+		case "PATCH":
+			maps.Copy((*list)[index], body)
+			write(200, (*list)[index])
+		case "DELETE":
+			*list = slices.Delete(*list, index, index+1)
+			if list == &config.triggers {
+				delete(config.triggerProperties, segments[1])
+			}
+			write(204, nil)
+		}
+	}))
+}
+
+var _ = Describe(`CdTektonPipelineV2 pipeline spec`, func() {
+	var config *mockPipelineConfig
+	var testServer *httptest.Server
+	var cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+
+	const specYAML = `
+worker:
+  id: public
+enable_notifications: true
+definitions:
+  - source:
+      type: git
+      properties:
+        url: https://github.com/org/app
+        branch: main
+        path: .tekton
+  - source:
+      type: git
+      properties:
+        url: https://github.com/org/tasks
+        branch: main
+        path: tasks
+properties:
+  - name: region
+    type: text
+    value: us-south
+  - name: api-key
+    type: secure
+    value: new-secret
+  - name: env
+    type: single_select
+    value: dev
+    enum: [dev, prod]
+triggers:
+  - name: manual
+    type: manual
+    event_listener: listener
+    properties:
+      - name: region
+        type: text
+        value: eu-de
+  - name: nightly
+    type: timer
+    event_listener: listener
+    cron: "0 2 * * *"
+    timezone: UTC
+    properties:
+      - name: mode
+        type: text
+        value: full
+`
+
+	BeforeEach(func() {
+		config = newMockPipelineConfig("PipelineID")
+		config.pipeline["worker"] = map[string]interface{}{"id": "public", "name": "IBM Managed workers"}
+		config.pipeline["enable_notifications"] = false
+		config.pipeline["enable_partial_cloning"] = false
+		source := func(url string, branch string) map[string]interface{} {
+			return map[string]interface{}{"type": "git", "properties": map[string]interface{}{"url": url, "branch": branch, "path": ".tekton"}}
+		}
+		config.definitions = []map[string]interface{}{
+			{"id": "def-1", "source": source("https://github.com/org/app", "develop")},
+			{"id": "def-2", "source": source("https://github.com/org/old", "main")},
+		}
+		config.properties = []map[string]interface{}{
+			{"name": "region", "type": "text", "value": "us-east"},
+			{"name": "api-key", "type": "secure", "value": "hash"},
+			{"name": "obsolete", "type": "text", "value": "x"},
+		}
+		config.triggers = []map[string]interface{}{
+			{"id": "trigger-1", "name": "manual", "type": "manual", "event_listener": "listener", "enabled": true},
+			{"id": "trigger-2", "name": "git", "type": "scm", "event_listener": "listener", "enabled": true},
+		}
+		config.triggerProperties["trigger-1"] = []map[string]interface{}{
+			{"name": "region", "type": "text", "value": "eu-de"},
+			{"name": "debug", "type": "text", "value": "true"},
+		}
+		config.triggerProperties["trigger-2"] = []map[string]interface{}{{"name": "branch", "type": "text", "value": "main"}}
+		testServer = config.server()
+		var serviceErr error
+		cdTektonPipelineService, serviceErr = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	plan := func(spec *cdtektonpipelinev2.TektonPipelineSpec) *cdtektonpipelinev2.TektonPipelinePlan {
+		result, err := cdTektonPipelineService.PlanTektonPipelineSpec(cdTektonPipelineService.NewPlanTektonPipelineSpecOptions("PipelineID", spec))
+		Expect(err).To(BeNil())
+		return result
+	}
+
+	It(`Invoke PlanTektonPipelineSpec and ApplyTektonPipelinePlan successfully`, func() {
+		specFile := filepath.Join(GinkgoT().TempDir(), "pipeline.yaml")
+		Expect(os.WriteFile(specFile, []byte(specYAML), 0o600)).To(Succeed())
+		spec, err := cdtektonpipelinev2.LoadTektonPipelineSpec(specFile)
+		Expect(err).To(BeNil())
+
+		result := plan(spec)
+		Expect(result.String()).To(Equal(`~ update pipeline (enable_notifications)
+~ replace definition "https://github.com/org/app/.tekton" (branch)
++ create definition "https://github.com/org/tasks/tasks"
+~ replace property "region" (value)
++ create property "env"
++ create trigger "nightly"
++ create trigger_property "nightly/mode"
+- delete trigger_property "manual/debug"
+- delete trigger "git"
+- delete property "obsolete"
+- delete definition "https://github.com/org/old/.tekton"
+`))
+		Expect(config.requestLog()).To(Equal([]string{
+			"GET /tekton_pipelines/PipelineID",
+			"GET /tekton_pipelines/PipelineID/definitions",
+			"GET /tekton_pipelines/PipelineID/properties",
+			"GET /tekton_pipelines/PipelineID/triggers",
+			"GET /tekton_pipelines/PipelineID/triggers/trigger-1/properties",
+		}))
+
+		config.requests = nil
+		result, err = cdTektonPipelineService.ApplyTektonPipelinePlan(cdTektonPipelineService.NewApplyTektonPipelinePlanOptions(result))
+		Expect(err).To(BeNil())
+		for _, action := range result.Actions {
+			Expect(action.Applied).To(BeTrue())
+		}
+		Expect(config.requestLog()).To(Equal([]string{
+			"PATCH /tekton_pipelines/PipelineID",
+			"PUT /tekton_pipelines/PipelineID/definitions/def-1",
+			"POST /tekton_pipelines/PipelineID/definitions",
+			"PUT /tekton_pipelines/PipelineID/properties/region",
+			"POST /tekton_pipelines/PipelineID/properties",
+			"POST /tekton_pipelines/PipelineID/triggers",
+			"POST /tekton_pipelines/PipelineID/triggers/new-2/properties",
+			"DELETE /tekton_pipelines/PipelineID/triggers/trigger-1/properties/debug",
+			"DELETE /tekton_pipelines/PipelineID/triggers/trigger-2",
+			"DELETE /tekton_pipelines/PipelineID/properties/obsolete",
+			"DELETE /tekton_pipelines/PipelineID/definitions/def-2",
+		}))
+		Expect(config.pipeline["enable_notifications"]).To(BeTrue())
+		Expect(config.properties).To(ContainElement(map[string]interface{}{"name": "env", "type": "single_select", "value": "dev", "enum": []interface{}{"dev", "prod"}}))
+		Expect(config.properties).To(ContainElement(map[string]interface{}{"name": "api-key", "type": "secure", "value": "hash"}))
+		Expect(config.triggers[1]).To(HaveKeyWithValue("cron", "0 2 * * *"))
+		Expect(config.triggerProperties["new-2"]).To(Equal([]map[string]interface{}{{"name": "mode", "type": "text", "value": "full"}}))
+
+		Expect(plan(spec).String()).To(Equal("pipeline PipelineID is up to date\n"))
+	})
+	It(`Invoke PlanTektonPipelineSpec with trigger updates and secure values`, func() {
+		spec, err := cdtektonpipelinev2.ParseTektonPipelineSpec([]byte(`{
+			"properties": [{"name": "api-key", "type": "secure", "value": "new-secret"}],
+			"triggers": [{"name": "manual", "type": "manual", "event_listener": "listener", "enabled": false, "tags": ["deploy"]}]
+		}`))
+		Expect(err).To(BeNil())
+		Expect(plan(spec).String()).To(Equal(`~ update trigger "manual" (tags, enabled)
+- delete trigger "git"
+- delete property "region"
+- delete property "obsolete"
+`))
+
+		result, err := cdTektonPipelineService.PlanTektonPipelineSpec(cdTektonPipelineService.NewPlanTektonPipelineSpecOptions("PipelineID", spec).SetReplaceSecureValues(true))
+		Expect(err).To(BeNil())
+		Expect(result.Actions[0].Name).To(Equal("api-key"))
+		Expect(result.Actions[0].Changes).To(Equal([]string{"value"}))
+
+		_, err = cdTektonPipelineService.ApplyTektonPipelinePlan(cdTektonPipelineService.NewApplyTektonPipelinePlanOptions(result))
+		Expect(err).To(BeNil())
+		Expect(config.triggers).To(HaveLen(1))
+		Expect(config.triggers[0]).To(HaveKeyWithValue("enabled", false))
+		Expect(config.triggers[0]).To(HaveKeyWithValue("tags", []interface{}{"deploy"}))
+		Expect(config.triggerProperties["trigger-1"]).To(HaveLen(2))
+		Expect(config.properties).To(Equal([]map[string]interface{}{{"name": "api-key", "type": "secure", "value": "new-secret"}}))
+	})
+	It(`Invoke PlanTektonPipelineSpec leaves the omitted fields and lists unchanged`, func() {
+		spec, err := cdtektonpipelinev2.ParseTektonPipelineSpec([]byte("enable_partial_cloning: true\n"))
+		Expect(err).To(BeNil())
+		result := plan(spec)
+		Expect(result.String()).To(Equal("~ update pipeline (enable_partial_cloning)\n"))
+		Expect(config.requestLog()).To(Equal([]string{"GET /tekton_pipelines/PipelineID"}))
+
+		spec, err = cdtektonpipelinev2.ParseTektonPipelineSpec([]byte("properties: []\n"))
+		Expect(err).To(BeNil())
+		Expect(plan(spec).String()).To(Equal(`- delete property "region"
+- delete property "api-key"
+- delete property "obsolete"
+`))
+	})
+	It(`Invoke ApplyTektonPipelinePlan stops at the first error and resumes after planning again`, func() {
+		spec, err := cdtektonpipelinev2.ParseTektonPipelineSpec([]byte(specYAML))
+		Expect(err).To(BeNil())
+		config.failing["DELETE /tekton_pipelines/PipelineID/triggers/trigger-2"] = true
+		result, err := cdTektonPipelineService.ApplyTektonPipelinePlan(cdTektonPipelineService.NewApplyTektonPipelinePlanOptions(plan(spec)))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("failed to delete trigger 'git'"))
+		Expect(result.Actions[7].Applied).To(BeTrue())
+		Expect(result.Actions[8].Applied).To(BeFalse())
+
+		delete(config.failing, "DELETE /tekton_pipelines/PipelineID/triggers/trigger-2")
+		result = plan(spec)
+		Expect(result.String()).To(Equal(`- delete trigger "git"
+- delete property "obsolete"
+- delete definition "https://github.com/org/old/.tekton"
+`))
+		_, err = cdTektonPipelineService.ApplyTektonPipelinePlan(cdTektonPipelineService.NewApplyTektonPipelinePlanOptions(result))
+		Expect(err).To(BeNil())
+		Expect(plan(spec).IsEmpty()).To(BeTrue())
+	})
+	It(`Invoke ParseTektonPipelineSpec with error: Invalid specs`, func() {
+		_, err := cdtektonpipelinev2.ParseTektonPipelineSpec([]byte("triggers:\n  - {name: a, type: manual, event_listener: l, crn: x}\n"))
+		Expect(err).ToNot(BeNil())
+		_, err = cdtektonpipelinev2.ParseTektonPipelineSpec([]byte("triggers:\n  - {name: a, type: manual}\n"))
+		Expect(err).ToNot(BeNil())
+		_, err = cdtektonpipelinev2.ParseTektonPipelineSpec([]byte("definitions:\n  - source: {type: git, properties: {url: u}}\n"))
+		Expect(err).ToNot(BeNil())
+		_, err = cdtektonpipelinev2.ParseTektonPipelineSpec([]byte("properties:\n  - {name: a, type: text}\n  - {name: a, type: secure}\n"))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("property 'a' is declared more than once"))
+		_, err = cdtektonpipelinev2.LoadTektonPipelineSpec(filepath.Join(GinkgoT().TempDir(), "missing.yaml"))
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Invoke PlanTektonPipelineSpec with error: Operation validation and request error`, func() {
+		_, err := cdTektonPipelineService.PlanTektonPipelineSpec(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.PlanTektonPipelineSpec(cdTektonPipelineService.NewPlanTektonPipelineSpecOptions("PipelineID", nil))
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.PlanTektonPipelineSpec(cdTektonPipelineService.NewPlanTektonPipelineSpecOptions("missing", &cdtektonpipelinev2.TektonPipelineSpec{}))
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.ApplyTektonPipelinePlan(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = cdTektonPipelineService.ApplyTektonPipelinePlan(cdTektonPipelineService.NewApplyTektonPipelinePlanOptions(&cdtektonpipelinev2.TektonPipelinePlan{
+			PipelineID: "PipelineID",
+			Actions:    []cdtektonpipelinev2.TektonPipelinePlanAction{{Action: "create", Resource: "pipeline"}},
+		}))
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,274 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// tektonStepContainerPrefix is the prefix Tekton adds to the container name of every step.
+const tektonStepContainerPrefix = "step-"
+
+// Tekton derives TaskRun pod names from the TaskRun name, optionally followed by "-pod", a random suffix and a retry
+// counter. Random suffixes use the Kubernetes alphabet, which has no vowels and no 0, 1 or 3, so that task names such
+// as "tests" are not mistaken for generated suffixes.
+var (
+	tektonPodSuffixPattern    = regexp.MustCompile(`-pod(-[bcdfghjklmnpqrstvwxz2456789]{5})?(-retry[0-9]+)?$`)
+	tektonRandomSuffixPattern = regexp.MustCompile(`-[bcdfghjklmnpqrstvwxz2456789]{5}$`)
+)
+
+// PipelineRunTopology : The TaskRun pods of a pipeline run and their steps, derived from the names of the run's logs.
+type PipelineRunTopology struct {
+	// The tasks of the pipeline run, in the order in which their first log is listed.
+	Tasks []PipelineRunTask
+}
+
+// PipelineRunTask : A TaskRun pod of a pipeline run.
+type PipelineRunTask struct {
+	// The task name, without the pipeline run prefix and the generated pod suffixes.
+	Name string
+
+	// The name of the pod as reported by the service.
+	PodName string
+
+	// The steps of the task, in the order in which their logs are listed.
+	Steps []PipelineRunStep
+}
+
+// PipelineRunStep : A step of a TaskRun pod and its log.
+type PipelineRunStep struct {
+	// The step name, without the "step-" container prefix.
+	Name string
+
+	// The name of the container as reported by the service.
+	ContainerName string
+
+	// The log of the step.
+	Log Log
+}
+
+// NewPipelineRunTopology groups the logs of a pipeline run into tasks and steps. Log names are documented as
+// `<podName>/<containerName>`. The run ID is used to strip the pipeline run prefix from pod names; it may be empty.
+func NewPipelineRunTopology(runID string, logs []Log) *PipelineRunTopology {
+	topology := &PipelineRunTopology{Tasks: []PipelineRunTask{}}
+	taskIndexes := make(map[string]int)
+	for _, log := range logs {
+		podName, containerName := splitLogName(core.StringNilMapper(log.Name))
+		index, found := taskIndexes[podName]
+		if !found {
+			index = len(topology.Tasks)
+			taskIndexes[podName] = index
+			topology.Tasks = append(topology.Tasks, PipelineRunTask{
+				Name:    TaskNameFromPodName(runID, podName),
+				PodName: podName,
+				Steps:   []PipelineRunStep{},
+			})
+		}
+		topology.Tasks[index].Steps = append(topology.Tasks[index].Steps, PipelineRunStep{
+			Name:          strings.TrimPrefix(containerName, tektonStepContainerPrefix),
+			ContainerName: containerName,
+			Log:           log,
+		})
+	}
+	return topology
+}
+
+// Task returns the task with the specified name, or nil if the run has no such task. When a task was retried, the
+// latest attempt is returned.
+func (topology *PipelineRunTopology) Task(taskName string) *PipelineRunTask {
+	for index := len(topology.Tasks) - 1; index >= 0; index-- {
+		if topology.Tasks[index].Name == taskName {
+			return &topology.Tasks[index]
+		}
+	}
+	return nil
+}
+
+// Step returns the step with the specified task and step names, or nil if the run has no such step.
+func (topology *PipelineRunTopology) Step(taskName string, stepName string) *PipelineRunStep {
+	task := topology.Task(taskName)
+	if task == nil {
+		return nil
+	}
+	return task.Step(stepName)
+}
+
+// Step returns the step with the specified name, or nil if the task has no such step.
+func (task *PipelineRunTask) Step(stepName string) *PipelineRunStep {
+	for index := range task.Steps {
+		if task.Steps[index].Name == stepName {
+			return &task.Steps[index]
+		}
+	}
+	return nil
+}
+
+// TaskNameFromPodName derives a task name from a TaskRun pod name by removing the pipeline run prefix, if the pod
+// name starts with the run ID, and the suffixes Tekton generates for pods and TaskRuns.
+func TaskNameFromPodName(runID string, podName string) string {
+	name := tektonPodSuffixPattern.ReplaceAllString(podName, "")
+	name = tektonRandomSuffixPattern.ReplaceAllString(name, "")
+	if runID != "" {
+		for _, prefix := range []string{"pipelinerun-" + runID + "-", runID + "-"} {
+			if strings.HasPrefix(name, prefix) && len(name) > len(prefix) {
+				name = strings.TrimPrefix(name, prefix)
+				break
+			}
+		}
+	}
+	return name
+}
+
+// splitLogName splits a log name of the form `<podName>/<containerName>`. A name without a separator is treated as a
+// container of an unnamed pod.
+func splitLogName(name string) (podName string, containerName string) {
+	if separator := strings.Index(name, "/"); separator >= 0 {
+		return name[:separator], name[separator+1:]
+	}
+	return "", name
+}
+
+// GetTektonPipelineRunTopology : Get the tasks and steps of a pipeline run
+// This lists the logs of the pipeline run identified by `{id}` and groups them into tasks and steps.
+func (cdTektonPipeline *CdTektonPipelineV2) GetTektonPipelineRunTopology(getTektonPipelineRunLogsOptions *GetTektonPipelineRunLogsOptions) (result *PipelineRunTopology, response *core.DetailedResponse, err error) {
+	result, response, err = cdTektonPipeline.GetTektonPipelineRunTopologyWithContext(context.Background(), getTektonPipelineRunLogsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetTektonPipelineRunTopologyWithContext is an alternate form of the GetTektonPipelineRunTopology method which supports a Context parameter
+func (cdTektonPipeline *CdTektonPipelineV2) GetTektonPipelineRunTopologyWithContext(ctx context.Context, getTektonPipelineRunLogsOptions *GetTektonPipelineRunLogsOptions) (result *PipelineRunTopology, response *core.DetailedResponse, err error) {
+	logs, response, err := cdTektonPipeline.GetTektonPipelineRunLogsWithContext(ctx, getTektonPipelineRunLogsOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "topology-get-logs-error")
+		return
+	}
+	result = NewPipelineRunTopology(*getTektonPipelineRunLogsOptions.ID, logs.Logs)
+	return
+}
+
+// GetTektonPipelineRunStepLogOptions : The GetTektonPipelineRunStepLog options.
+type GetTektonPipelineRunStepLogOptions struct {
+	// The Tekton pipeline ID.
+	PipelineID *string `json:"pipeline_id" validate:"required,ne="`
+
+	// The Tekton pipeline run ID.
+	PipelineRunID *string `json:"pipeline_run_id" validate:"required,ne="`
+
+	// The task name, as reported by PipelineRunTask.Name.
+	TaskName *string `json:"task_name" validate:"required,ne="`
+
+	// The step name, as reported by PipelineRunStep.Name.
+	StepName *string `json:"step_name" validate:"required,ne="`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewGetTektonPipelineRunStepLogOptions : Instantiate GetTektonPipelineRunStepLogOptions
+func (*CdTektonPipelineV2) NewGetTektonPipelineRunStepLogOptions(pipelineID string, pipelineRunID string, taskName string, stepName string) *GetTektonPipelineRunStepLogOptions {
+	return &GetTektonPipelineRunStepLogOptions{
+		PipelineID:    core.StringPtr(pipelineID),
+		PipelineRunID: core.StringPtr(pipelineRunID),
+		TaskName:      core.StringPtr(taskName),
+		StepName:      core.StringPtr(stepName),
+	}
+}
+
+// SetPipelineID : Allow user to set PipelineID
+func (_options *GetTektonPipelineRunStepLogOptions) SetPipelineID(pipelineID string) *GetTektonPipelineRunStepLogOptions {
+	_options.PipelineID = core.StringPtr(pipelineID)
+	return _options
+}
+
+// SetPipelineRunID : Allow user to set PipelineRunID
+func (_options *GetTektonPipelineRunStepLogOptions) SetPipelineRunID(pipelineRunID string) *GetTektonPipelineRunStepLogOptions {
+	_options.PipelineRunID = core.StringPtr(pipelineRunID)
+	return _options
+}
+
+// SetTaskName : Allow user to set TaskName
+func (_options *GetTektonPipelineRunStepLogOptions) SetTaskName(taskName string) *GetTektonPipelineRunStepLogOptions {
+	_options.TaskName = core.StringPtr(taskName)
+	return _options
+}
+
+// SetStepName : Allow user to set StepName
+func (_options *GetTektonPipelineRunStepLogOptions) SetStepName(stepName string) *GetTektonPipelineRunStepLogOptions {
+	_options.StepName = core.StringPtr(stepName)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *GetTektonPipelineRunStepLogOptions) SetHeaders(param map[string]string) *GetTektonPipelineRunStepLogOptions {
+	options.Headers = param
+	return options
+}
+
+// GetTektonPipelineRunStepLog : Get the log of a pipeline run step by task and step name
+// This resolves the step through the run topology and fetches the content of its log.
+func (cdTektonPipeline *CdTektonPipelineV2) GetTektonPipelineRunStepLog(getTektonPipelineRunStepLogOptions *GetTektonPipelineRunStepLogOptions) (result *StepLog, response *core.DetailedResponse, err error) {
+	result, response, err = cdTektonPipeline.GetTektonPipelineRunStepLogWithContext(context.Background(), getTektonPipelineRunStepLogOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetTektonPipelineRunStepLogWithContext is an alternate form of the GetTektonPipelineRunStepLog method which supports a Context parameter
+func (cdTektonPipeline *CdTektonPipelineV2) GetTektonPipelineRunStepLogWithContext(ctx context.Context, getTektonPipelineRunStepLogOptions *GetTektonPipelineRunStepLogOptions) (result *StepLog, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(getTektonPipelineRunStepLogOptions, "getTektonPipelineRunStepLogOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(getTektonPipelineRunStepLogOptions, "getTektonPipelineRunStepLogOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	topology, response, err := cdTektonPipeline.GetTektonPipelineRunTopologyWithContext(ctx, &GetTektonPipelineRunLogsOptions{
+		PipelineID: getTektonPipelineRunStepLogOptions.PipelineID,
+		ID:         getTektonPipelineRunStepLogOptions.PipelineRunID,
+		Headers:    getTektonPipelineRunStepLogOptions.Headers,
+	})
+	if err != nil {
+		return
+	}
+
+	taskName := *getTektonPipelineRunStepLogOptions.TaskName
+	stepName := *getTektonPipelineRunStepLogOptions.StepName
+	step := topology.Step(taskName, stepName)
+	if step == nil {
+		err = core.SDKErrorf(nil, fmt.Sprintf("pipeline run has no step '%s' in task '%s'", stepName, taskName), "step-not-found", common.GetComponentInfo())
+		return
+	}
+
+	result, response, err = cdTektonPipeline.GetTektonPipelineRunLogContentWithContext(ctx, &GetTektonPipelineRunLogContentOptions{
+		PipelineID:    getTektonPipelineRunStepLogOptions.PipelineID,
+		PipelineRunID: getTektonPipelineRunStepLogOptions.PipelineRunID,
+		ID:            step.Log.ID,
+		Headers:       getTektonPipelineRunStepLogOptions.Headers,
+	})
+	err = core.RepurposeSDKProblem(err, "step-log-content-error")
+	return
+}
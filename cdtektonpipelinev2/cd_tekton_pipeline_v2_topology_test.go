@@ -0,0 +1,115 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"net/http/httptest"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CdTektonPipelineV2 PipelineRunTopology`, func() {
+	DescribeTable(`TaskNameFromPodName`,
+		func(runID string, podName string, expected string) {
+			Expect(cdtektonpipelinev2.TaskNameFromPodName(runID, podName)).To(Equal(expected))
+		},
+		Entry(`pod suffix`, "", "build-pod", "build"),
+		Entry(`pod and random suffix`, "", "build-pod-x7kqz", "build"),
+		Entry(`random TaskRun suffix`, "", "build-x7kqz-pod-b2c4d", "build"),
+		Entry(`retried pod`, "", "build-pod-retry2", "build"),
+		Entry(`task name resembling a suffix`, "", "unit-tests-pod", "unit-tests"),
+		Entry(`run ID prefix`, "1234", "1234-deploy-pod", "deploy"),
+		Entry(`pipelinerun prefix`, "1234", "pipelinerun-1234-deploy-pod", "deploy"),
+		Entry(`unrelated prefix`, "1234", "other-deploy-pod", "other-deploy"),
+	)
+
+	It(`Invoke NewPipelineRunTopology to group logs into tasks and steps`, func() {
+		topology := cdtektonpipelinev2.NewPipelineRunTopology("1234", []cdtektonpipelinev2.Log{
+			{ID: core.StringPtr("a"), Name: core.StringPtr("1234-build-pod/step-compile")},
+			{ID: core.StringPtr("b"), Name: core.StringPtr("1234-build-pod/step-unit-test")},
+			{ID: core.StringPtr("c"), Name: core.StringPtr("1234-deploy-pod/step-apply")},
+			{ID: core.StringPtr("d"), Name: core.StringPtr("1234-build-pod-retry1/step-unit-test")},
+			{ID: core.StringPtr("e"), Name: core.StringPtr("orphan")},
+		})
+		Expect(topology.Tasks).To(HaveLen(4))
+		Expect(topology.Tasks[0].Name).To(Equal("build"))
+		Expect(topology.Tasks[0].PodName).To(Equal("1234-build-pod"))
+		Expect(topology.Tasks[0].Steps).To(HaveLen(2))
+		Expect(topology.Tasks[0].Steps[1].Name).To(Equal("unit-test"))
+		Expect(topology.Tasks[0].Steps[1].ContainerName).To(Equal("step-unit-test"))
+		Expect(topology.Tasks[1].Name).To(Equal("deploy"))
+		Expect(topology.Tasks[3].Name).To(BeEmpty())
+		Expect(topology.Tasks[3].Steps[0].Name).To(Equal("orphan"))
+
+		Expect(*topology.Step("build", "unit-test").Log.ID).To(Equal("d"))
+		Expect(topology.Step("build", "compile")).To(BeNil())
+		Expect(topology.Step("build", "missing")).To(BeNil())
+		Expect(topology.Step("missing", "compile")).To(BeNil())
+	})
+
+	Describe(`GetTektonPipelineRunStepLog(getTektonPipelineRunStepLogOptions *GetTektonPipelineRunStepLogOptions)`, func() {
+		var testServer *httptest.Server
+		var cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+
+		BeforeEach(func() {
+			testServer = newLogPollServer(logPoll{status: "failed", logs: [][2]string{
+				{"RunID-build-pod/step-compile", "compiling\n"},
+				{"RunID-build-pod/step-unit-test", "1 failed\n"},
+			}})
+			var serviceErr error
+			cdTektonPipelineService, serviceErr = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		It(`Invoke GetTektonPipelineRunTopology successfully`, func() {
+			topology, response, err := cdTektonPipelineService.GetTektonPipelineRunTopology(
+				cdTektonPipelineService.NewGetTektonPipelineRunLogsOptions("PipelineID", "RunID"))
+			Expect(err).To(BeNil())
+			Expect(response).ToNot(BeNil())
+			Expect(topology.Tasks).To(HaveLen(1))
+			Expect(topology.Tasks[0].Name).To(Equal("build"))
+		})
+		It(`Invoke GetTektonPipelineRunStepLog successfully`, func() {
+			stepLog, response, err := cdTektonPipelineService.GetTektonPipelineRunStepLog(
+				cdTektonPipelineService.NewGetTektonPipelineRunStepLogOptions("PipelineID", "RunID", "build", "unit-test"))
+			Expect(err).To(BeNil())
+			Expect(response).ToNot(BeNil())
+			Expect(*stepLog.Data).To(Equal("1 failed\n"))
+		})
+		It(`Invoke GetTektonPipelineRunStepLog with error: Operation validation and request error`, func() {
+			_, _, err := cdTektonPipelineService.GetTektonPipelineRunStepLog(nil)
+			Expect(err).ToNot(BeNil())
+
+			_, _, err = cdTektonPipelineService.GetTektonPipelineRunStepLog(
+				cdTektonPipelineService.NewGetTektonPipelineRunStepLogOptions("PipelineID", "RunID", "build", "lint"))
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("no step 'lint' in task 'build'"))
+
+			_, _, err = cdTektonPipelineService.GetTektonPipelineRunTopology(nil)
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
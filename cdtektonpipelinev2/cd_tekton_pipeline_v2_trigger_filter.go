@@ -0,0 +1,396 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+
+	"cel.dev/cel-go/cel"
+	"cel.dev/cel-go/ext"
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// triggerFilterEnv returns the CEL environment of the trigger filters, which declares the variables exposed by the
+// service: `header`, the webhook headers with lowercase names; `body`, the JSON payload; and `event`, the type of Git
+// event, one of the TriggerEvents*Const constants.
+var triggerFilterEnv = sync.OnceValues(func() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("header", cel.MapType(cel.StringType, cel.StringType)),
+		cel.Variable("body", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("event", cel.StringType),
+		ext.Strings(),
+	)
+})
+
+// TriggerFilter : The compiled CEL expression of the filter of a trigger.
+type TriggerFilter struct {
+	// The CEL expression.
+	Expression string
+
+	program cel.Program
+}
+
+// CompileTriggerFilter parses and type-checks the CEL expression of a trigger filter against the variables exposed by
+// the service: `header`, `body` and `event`. Undeclared variables, unknown functions, type mismatches and expressions
+// that do not evaluate to a bool are rejected.
+func CompileTriggerFilter(expression string) (filter *TriggerFilter, err error) {
+	env, err := triggerFilterEnv()
+	if err != nil {
+		err = core.SDKErrorf(err, "", "trigger-filter-env-error", common.GetComponentInfo())
+		return
+	}
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		err = core.SDKErrorf(nil, fmt.Sprintf("invalid trigger filter '%s':\n%s", expression, issues.String()), "invalid-trigger-filter", common.GetComponentInfo())
+		return
+	}
+	if outputType := ast.OutputType(); !outputType.IsExactType(cel.BoolType) && !outputType.IsExactType(cel.DynType) {
+		err = core.SDKErrorf(nil, fmt.Sprintf("invalid trigger filter '%s': the expression evaluates to a %s, not a bool", expression, outputType), "invalid-trigger-filter", common.GetComponentInfo())
+		return
+	}
+	program, err := env.Program(ast)
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("invalid trigger filter '%s': %s", expression, err.Error()), "invalid-trigger-filter", common.GetComponentInfo())
+		return
+	}
+	filter = &TriggerFilter{Expression: expression, program: program}
+	return
+}
+
+// Matches evaluates the filter against an event and tells whether the trigger fires. The service does not fire a
+// trigger whose filter fails to evaluate, for example because it selects a field that is missing from the payload;
+// the failure is returned as an error.
+func (filter *TriggerFilter) Matches(event *TriggerFilterEvent) (fires bool, err error) {
+	err = core.ValidateNotNil(event, "event cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	header := make(map[string]string, len(event.Header))
+	for name, value := range event.Header {
+		header[strings.ToLower(name)] = value
+	}
+	body := event.Body
+	if body == nil {
+		body = map[string]interface{}{}
+	}
+	out, _, err := filter.program.Eval(map[string]interface{}{
+		"header": header,
+		"body":   body,
+		"event":  event.Event,
+	})
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("cannot evaluate trigger filter '%s' against event '%s': %s", filter.Expression, event.Name, err.Error()), "trigger-filter-evaluation-error", common.GetComponentInfo())
+		return
+	}
+	fires, ok := out.Value().(bool)
+	if !ok {
+		err = core.SDKErrorf(nil, fmt.Sprintf("trigger filter '%s' evaluates to a %s against event '%s', not a bool", filter.Expression, out.Type().TypeName(), event.Name), "trigger-filter-evaluation-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// TriggerFilterResult : The outcome of the evaluation of a trigger filter against an event.
+type TriggerFilterResult struct {
+	// The name of the event.
+	Event string
+
+	// Whether the trigger fires.
+	Fires bool
+
+	// Why the filter failed to evaluate, in which case the trigger does not fire.
+	Error string
+}
+
+// Preview evaluates the filter against each event, such as the events of SampleTriggerFilterEvents, and reports
+// whether the trigger would fire.
+func (filter *TriggerFilter) Preview(events []TriggerFilterEvent) (results []TriggerFilterResult) {
+	for index := range events {
+		result := TriggerFilterResult{Event: events[index].Name}
+		fires, err := filter.Matches(&events[index])
+		if err != nil {
+			result.Error = err.Error()
+		}
+		result.Fires = fires
+		results = append(results, result)
+	}
+	return
+}
+
+// TriggerFilterEvent : A Git webhook delivery, against which trigger filters are evaluated.
+type TriggerFilterEvent struct {
+	// The name of the event, used in reports.
+	Name string
+
+	// The type of Git event, one of the TriggerEvents*Const constants.
+	Event string
+
+	// The webhook headers. Their names are lowercased for the evaluation.
+	Header map[string]string
+
+	// The JSON payload.
+	Body map[string]interface{}
+}
+
+// NewTriggerFilterEvent returns the event of a webhook delivery with the specified headers and JSON payload. The type
+// of Git event is recognized from the GitHub, GitLab or Bitbucket headers and payload, as DecodeEventParams does:
+// pushes are `push` events, and pull requests or merge requests are `pull_request` events, or `pull_request_closed`
+// events when they are closed or merged.
+func NewTriggerFilterEvent(name string, header map[string]string, body []byte) (event *TriggerFilterEvent, err error) {
+	var payload map[string]interface{}
+	if err = json.Unmarshal(body, &payload); err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("invalid payload of event '%s': %s", name, err.Error()), "invalid-trigger-filter-event", common.GetComponentInfo())
+		return
+	}
+	headers := http.Header{}
+	for key, value := range header {
+		headers.Set(key, value)
+	}
+	event = &TriggerFilterEvent{Name: name, Header: header, Body: payload}
+	git, kind := decodeGitEvent(headers, body, payload)
+	switch kind {
+	case PipelineRunEventParamsKindGitPushConst:
+		event.Event = TriggerEventsPushConst
+	case PipelineRunEventParamsKindPullRequestConst:
+		switch git.PullRequestAction {
+		case "closed", "close", "merge", "fulfilled", "rejected":
+			event.Event = TriggerEventsPullRequestClosedConst
+		default:
+			event.Event = TriggerEventsPullRequestConst
+		}
+	}
+	return
+}
+
+// triggerFilterFixtures are the webhook deliveries of SampleTriggerFilterEvents, trimmed to the most used fields.
+var triggerFilterFixtures = []struct {
+	name   string
+	header map[string]string
+	body   string
+}{
+	{
+		name:   "github-push",
+		header: map[string]string{"Content-Type": "application/json", "X-GitHub-Event": "push"},
+		body: `{"ref": "refs/heads/main", "before": "6113728f27ae82c7b1a177c8d03f9e96e0adf246",
+			"after": "59b20b8d5c6ff8d09518454d4dd8b7b30f095ab5", "created": false, "deleted": false, "forced": false,
+			"repository": {"name": "app", "full_name": "org/app", "html_url": "https://github.com/org/app", "default_branch": "main"},
+			"pusher": {"name": "dev", "email": "dev@example.com"}, "sender": {"login": "dev"},
+			"head_commit": {"id": "59b20b8d5c6ff8d09518454d4dd8b7b30f095ab5", "message": "Fix the build",
+				"author": {"name": "Dev", "email": "dev@example.com"}, "added": [], "removed": [], "modified": ["main.go"]},
+			"commits": [{"id": "59b20b8d5c6ff8d09518454d4dd8b7b30f095ab5", "message": "Fix the build",
+				"author": {"name": "Dev", "email": "dev@example.com"}, "added": [], "removed": [], "modified": ["main.go"]}]}`,
+	},
+	{
+		name:   "github-tag-push",
+		header: map[string]string{"Content-Type": "application/json", "X-GitHub-Event": "push"},
+		body: `{"ref": "refs/tags/v1.0.0", "before": "0000000000000000000000000000000000000000",
+			"after": "59b20b8d5c6ff8d09518454d4dd8b7b30f095ab5", "created": true, "deleted": false, "forced": false,
+			"base_ref": "refs/heads/main",
+			"repository": {"name": "app", "full_name": "org/app", "html_url": "https://github.com/org/app", "default_branch": "main"},
+			"pusher": {"name": "dev", "email": "dev@example.com"}, "sender": {"login": "dev"},
+			"head_commit": {"id": "59b20b8d5c6ff8d09518454d4dd8b7b30f095ab5", "message": "Fix the build",
+				"author": {"name": "Dev", "email": "dev@example.com"}, "added": [], "removed": [], "modified": ["main.go"]},
+			"commits": []}`,
+	},
+	{
+		name:   "github-pull-request-opened",
+		header: map[string]string{"Content-Type": "application/json", "X-GitHub-Event": "pull_request"},
+		body: `{"action": "opened", "number": 42,
+			"pull_request": {"number": 42, "state": "open", "title": "Add a feature", "draft": false, "merged": false,
+				"html_url": "https://github.com/org/app/pull/42", "user": {"login": "dev"}, "labels": [{"name": "enhancement"}],
+				"head": {"ref": "feature/login", "sha": "a10867b14bb761a232cd80139fbd4c0d33264240"},
+				"base": {"ref": "main", "sha": "59b20b8d5c6ff8d09518454d4dd8b7b30f095ab5"}},
+			"repository": {"name": "app", "full_name": "org/app", "html_url": "https://github.com/org/app", "default_branch": "main"},
+			"sender": {"login": "dev"}}`,
+	},
+	{
+		name:   "github-pull-request-closed",
+		header: map[string]string{"Content-Type": "application/json", "X-GitHub-Event": "pull_request"},
+		body: `{"action": "closed", "number": 42,
+			"pull_request": {"number": 42, "state": "closed", "title": "Add a feature", "draft": false, "merged": true,
+				"html_url": "https://github.com/org/app/pull/42", "user": {"login": "dev"}, "labels": [{"name": "enhancement"}],
+				"head": {"ref": "feature/login", "sha": "a10867b14bb761a232cd80139fbd4c0d33264240"},
+				"base": {"ref": "main", "sha": "59b20b8d5c6ff8d09518454d4dd8b7b30f095ab5"}},
+			"repository": {"name": "app", "full_name": "org/app", "html_url": "https://github.com/org/app", "default_branch": "main"},
+			"sender": {"login": "maintainer"}}`,
+	},
+	{
+		name:   "gitlab-push",
+		header: map[string]string{"Content-Type": "application/json", "X-Gitlab-Event": "Push Hook"},
+		body: `{"object_kind": "push", "event_name": "push", "ref": "refs/heads/main",
+			"before": "95790bf891e76fee5e1747ab589903a6a1f80f22", "after": "da1560886d4f094c3e6c9ef40349f7d38b5d27d7",
+			"checkout_sha": "da1560886d4f094c3e6c9ef40349f7d38b5d27d7",
+			"user_name": "Dev", "user_username": "dev", "user_email": "dev@example.com",
+			"project": {"name": "app", "path_with_namespace": "org/app", "web_url": "https://gitlab.com/org/app", "default_branch": "main"},
+			"commits": [{"id": "da1560886d4f094c3e6c9ef40349f7d38b5d27d7", "message": "Fix the build",
+				"author": {"name": "Dev", "email": "dev@example.com"}, "added": [], "removed": [], "modified": ["main.go"]}],
+			"total_commits_count": 1}`,
+	},
+	{
+		name:   "gitlab-merge-request-opened",
+		header: map[string]string{"Content-Type": "application/json", "X-Gitlab-Event": "Merge Request Hook"},
+		body: `{"object_kind": "merge_request", "event_type": "merge_request",
+			"user": {"name": "Dev", "username": "dev", "email": "dev@example.com"},
+			"project": {"name": "app", "path_with_namespace": "org/app", "web_url": "https://gitlab.com/org/app", "default_branch": "main"},
+			"object_attributes": {"iid": 7, "title": "Add a feature", "state": "opened", "action": "open", "draft": false,
+				"source_branch": "feature/login", "target_branch": "main", "url": "https://gitlab.com/org/app/-/merge_requests/7",
+				"last_commit": {"id": "b83d6e391c22777fca1ed3012fce84f633d7fed0", "message": "Add a feature",
+					"author": {"name": "Dev", "email": "dev@example.com"}}},
+			"labels": [{"title": "enhancement"}]}`,
+	},
+	{
+		name:   "gitlab-merge-request-merged",
+		header: map[string]string{"Content-Type": "application/json", "X-Gitlab-Event": "Merge Request Hook"},
+		body: `{"object_kind": "merge_request", "event_type": "merge_request",
+			"user": {"name": "Maintainer", "username": "maintainer", "email": "maintainer@example.com"},
+			"project": {"name": "app", "path_with_namespace": "org/app", "web_url": "https://gitlab.com/org/app", "default_branch": "main"},
+			"object_attributes": {"iid": 7, "title": "Add a feature", "state": "merged", "action": "merge", "draft": false,
+				"source_branch": "feature/login", "target_branch": "main", "url": "https://gitlab.com/org/app/-/merge_requests/7",
+				"last_commit": {"id": "b83d6e391c22777fca1ed3012fce84f633d7fed0", "message": "Add a feature",
+					"author": {"name": "Dev", "email": "dev@example.com"}}},
+			"labels": [{"title": "enhancement"}]}`,
+	},
+}
+
+// SampleTriggerFilterEvents returns sample GitHub and GitLab webhook deliveries: a push to the `main` branch of the
+// `org/app` repository, a push of the `v1.0.0` tag, and a pull request or merge request from the `feature/login`
+// branch to `main`, opened then merged.
+func SampleTriggerFilterEvents() (events []TriggerFilterEvent) {
+	for _, fixture := range triggerFilterFixtures {
+		event, err := NewTriggerFilterEvent(fixture.name, fixture.header, []byte(fixture.body))
+		if err != nil {
+			panic(err)
+		}
+		events = append(events, *event)
+	}
+	return
+}
+
+// triggerWritePath matches the paths of the requests creating and updating triggers, which carry their filter.
+var triggerWritePath = regexp.MustCompile(`/tekton_pipelines/[^/]+/triggers(/[^/]+)?$`)
+
+// triggerFilterValidator : An http.RoundTripper checking the filter of the triggers created or updated through it.
+// An invalid filter is answered with a 400 status code without sending the request, so that it is not retried.
+type triggerFilterValidator struct {
+	next http.RoundTripper
+}
+
+// RoundTrip checks the filter of a trigger creation or update, and sends the request if it is valid.
+func (validator *triggerFilterValidator) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := validator.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	match := triggerWritePath.FindStringSubmatch(req.URL.Path)
+	if match == nil || req.Body == nil || (req.Method != http.MethodPost || match[1] != "") && (req.Method != http.MethodPatch || match[1] == "") {
+		return next.RoundTrip(req)
+	}
+
+	data, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(data)), nil
+	}
+
+	body := data
+	if req.Header.Get("Content-Encoding") == "gzip" {
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		if body, err = io.ReadAll(reader); err != nil {
+			return nil, err
+		}
+	}
+	var trigger map[string]interface{}
+	if json.Unmarshal(body, &trigger) != nil {
+		return next.RoundTrip(req)
+	}
+	if filterErr := validateTriggerFilter(trigger["filter"]); filterErr != nil {
+		problem, _ := json.Marshal(map[string]interface{}{
+			"errors": []map[string]string{{"code": "invalid-trigger-filter", "message": filterErr.Error()}},
+		})
+		return &http.Response{
+			Status:        "400 Bad Request",
+			StatusCode:    http.StatusBadRequest,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        http.Header{"Content-Type": []string{"application/json"}},
+			Body:          io.NopCloser(bytes.NewReader(problem)),
+			ContentLength: int64(len(problem)),
+			Request:       req,
+		}, nil
+	}
+	return next.RoundTrip(req)
+}
+
+// validateTriggerFilter compiles the filter of a trigger body, unless it is missing or empty.
+func validateTriggerFilter(filterIntf interface{}) error {
+	if filterIntf == nil {
+		return nil
+	}
+	filter, ok := filterIntf.(string)
+	if !ok {
+		return core.SDKErrorf(nil, fmt.Sprintf("invalid trigger filter: expected a string, not a %T", filterIntf), "invalid-trigger-filter", common.GetComponentInfo())
+	}
+	if filter == "" {
+		return nil
+	}
+	_, err := CompileTriggerFilter(filter)
+	return err
+}
+
+// SetValidateTriggerFilters : Check the trigger filters before sending them to the service
+// When enabled, the CEL expression of the filter of the triggers created with CreateTektonPipelineTrigger or updated
+// with UpdateTektonPipelineTrigger is compiled with CompileTriggerFilter, and an invalid filter is rejected with a 400
+// status code without any request to the service. The check is set on the HTTP client of the service, so it is
+// dropped when the client is replaced with SetHTTPClient.
+func (cdTektonPipeline *CdTektonPipelineV2) SetValidateTriggerFilters(validate bool) {
+	client := cdTektonPipeline.Service.GetHTTPClient()
+	validator, validating := client.Transport.(*triggerFilterValidator)
+	if validate == validating {
+		return
+	}
+	updated := *client
+	if validate {
+		updated.Transport = &triggerFilterValidator{next: client.Transport}
+	} else {
+		updated.Transport = validator.next
+	}
+	cdTektonPipeline.Service.SetHTTPClient(&updated)
+}
+
+// GetValidateTriggerFilters returns whether the trigger filters are checked before being sent to the service.
+func (cdTektonPipeline *CdTektonPipelineV2) GetValidateTriggerFilters() bool {
+	_, validating := cdTektonPipeline.Service.GetHTTPClient().Transport.(*triggerFilterValidator)
+	return validating
+}
@@ -0,0 +1,179 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CdTektonPipelineV2 trigger filters`, func() {
+	firing := func(expression string) []string {
+		filter, err := cdtektonpipelinev2.CompileTriggerFilter(expression)
+		Expect(err).To(BeNil(), expression)
+		names := []string{}
+		for _, result := range filter.Preview(cdtektonpipelinev2.SampleTriggerFilterEvents()) {
+			if result.Fires {
+				names = append(names, result.Event)
+			}
+		}
+		return names
+	}
+
+	It(`Invoke SampleTriggerFilterEvents successfully`, func() {
+		events := map[string]string{}
+		for _, event := range cdtektonpipelinev2.SampleTriggerFilterEvents() {
+			events[event.Name] = event.Event
+		}
+		Expect(events).To(Equal(map[string]string{
+			"github-push":                 "push",
+			"github-tag-push":             "push",
+			"github-pull-request-opened":  "pull_request",
+			"github-pull-request-closed":  "pull_request_closed",
+			"gitlab-push":                 "push",
+			"gitlab-merge-request-opened": "pull_request",
+			"gitlab-merge-request-merged": "pull_request_closed",
+		}))
+	})
+	It(`Invoke CompileTriggerFilter and Preview successfully`, func() {
+		Expect(firing(`event == 'push' && body.ref == 'refs/heads/main'`)).To(Equal([]string{"github-push", "gitlab-push"}))
+		Expect(firing(`header['x-github-event'] == 'push' && body.ref.startsWith('refs/tags/')`)).To(Equal([]string{"github-tag-push"}))
+		Expect(firing(`event == 'pull_request_closed'`)).To(Equal([]string{"github-pull-request-closed", "gitlab-merge-request-merged"}))
+		Expect(firing(`body.pull_request.base.ref == 'main' && body.pull_request.labels.exists(l, l.name == 'enhancement')`)).
+			To(Equal([]string{"github-pull-request-opened", "github-pull-request-closed"}))
+		Expect(firing(`'object_attributes' in body && body.object_attributes.source_branch.lowerAscii().startsWith('feature/')`)).
+			To(Equal([]string{"gitlab-merge-request-opened", "gitlab-merge-request-merged"}))
+		Expect(firing(`body.number == 42`)).To(Equal([]string{"github-pull-request-opened", "github-pull-request-closed"}))
+
+		filter, err := cdtektonpipelinev2.CompileTriggerFilter(`body.ref == 'refs/heads/main'`)
+		Expect(err).To(BeNil())
+		results := filter.Preview(cdtektonpipelinev2.SampleTriggerFilterEvents())
+		Expect(results[0]).To(Equal(cdtektonpipelinev2.TriggerFilterResult{Event: "github-push", Fires: true}))
+		Expect(results[2].Fires).To(BeFalse())
+		Expect(results[2].Error).To(ContainSubstring("no such key: ref"))
+
+		event, err := cdtektonpipelinev2.NewTriggerFilterEvent("custom", map[string]string{"X-Custom": "yes"}, []byte(`{"kind": "deploy"}`))
+		Expect(err).To(BeNil())
+		Expect(event.Event).To(Equal(""))
+		filter, err = cdtektonpipelinev2.CompileTriggerFilter(`header['x-custom'] == 'yes' && body.kind == 'deploy'`)
+		Expect(err).To(BeNil())
+		fires, err := filter.Matches(event)
+		Expect(err).To(BeNil())
+		Expect(fires).To(BeTrue())
+	})
+	It(`Invoke CompileTriggerFilter with error: Invalid filters`, func() {
+		for expression, message := range map[string]string{
+			`bdy.ref == 'refs/heads/main'`:    "undeclared reference to 'bdy'",
+			`event = 'push'`:                  "Syntax error",
+			`event == 1`:                      "found no matching overload for '_==_' applied to '(string, int)'",
+			`header['x-github-event'].size()`: "evaluates to a int, not a bool",
+			`body.ref.startswith('refs/')`:    "undeclared reference to 'startswith'",
+		} {
+			_, err := cdtektonpipelinev2.CompileTriggerFilter(expression)
+			Expect(err).ToNot(BeNil(), expression)
+			Expect(err.Error()).To(ContainSubstring(message), expression)
+		}
+
+		filter, err := cdtektonpipelinev2.CompileTriggerFilter(`body.ref`)
+		Expect(err).To(BeNil())
+		_, err = filter.Matches(&cdtektonpipelinev2.SampleTriggerFilterEvents()[0])
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("evaluates to a string against event 'github-push', not a bool"))
+		_, err = filter.Matches(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = cdtektonpipelinev2.NewTriggerFilterEvent("broken", nil, []byte(`{`))
+		Expect(err).ToNot(BeNil())
+	})
+
+	Describe(`SetValidateTriggerFilters(validate bool)`, func() {
+		var config *mockPipelineConfig
+		var testServer *httptest.Server
+		var cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+
+		BeforeEach(func() {
+			config = newMockPipelineConfig("PipelineID")
+			config.triggers = []map[string]interface{}{{"id": "trigger-1", "name": "on-push", "type": "scm", "event_listener": "listener"}}
+			testServer = config.server()
+			var serviceErr error
+			cdTektonPipelineService, serviceErr = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+			cdTektonPipelineService.SetValidateTriggerFilters(true)
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		It(`Invoke CreateTektonPipelineTrigger and UpdateTektonPipelineTrigger with valid filters`, func() {
+			Expect(cdTektonPipelineService.GetValidateTriggerFilters()).To(BeTrue())
+			createOptions := cdTektonPipelineService.NewCreateTektonPipelineTriggerOptions("PipelineID", "scm", "on-pr", "listener").
+				SetFilter(`event == 'pull_request' && body.pull_request.base.ref == 'main'`)
+			_, _, err := cdTektonPipelineService.CreateTektonPipelineTrigger(createOptions)
+			Expect(err).To(BeNil())
+
+			updateOptions := cdTektonPipelineService.NewUpdateTektonPipelineTriggerOptions("PipelineID", "trigger-1").
+				SetTriggerPatch(map[string]interface{}{"filter": `event == 'push'`})
+			_, _, err = cdTektonPipelineService.UpdateTektonPipelineTrigger(updateOptions)
+			Expect(err).To(BeNil())
+			updateOptions.SetTriggerPatch(map[string]interface{}{"name": "renamed"})
+			_, _, err = cdTektonPipelineService.UpdateTektonPipelineTrigger(updateOptions)
+			Expect(err).To(BeNil())
+			Expect(config.requestLog()).To(Equal([]string{
+				"POST /tekton_pipelines/PipelineID/triggers",
+				"PATCH /tekton_pipelines/PipelineID/triggers/trigger-1",
+				"PATCH /tekton_pipelines/PipelineID/triggers/trigger-1",
+			}))
+			Expect(config.triggers[0]).To(HaveKeyWithValue("filter", `event == 'push'`))
+		})
+		It(`Invoke CreateTektonPipelineTrigger and UpdateTektonPipelineTrigger with error: Invalid filter`, func() {
+			cdTektonPipelineService.EnableRetries(3, 10*time.Millisecond)
+			createOptions := cdTektonPipelineService.NewCreateTektonPipelineTriggerOptions("PipelineID", "scm", "on-pr", "listener").
+				SetFilter(`body.pull_request.base.ref = 'main'`)
+			_, response, err := cdTektonPipelineService.CreateTektonPipelineTrigger(createOptions)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("invalid trigger filter"))
+			Expect(response.StatusCode).To(Equal(400))
+
+			updateOptions := cdTektonPipelineService.NewUpdateTektonPipelineTriggerOptions("PipelineID", "trigger-1").
+				SetTriggerPatch(map[string]interface{}{"filter": `evnt == 'push'`})
+			_, _, err = cdTektonPipelineService.UpdateTektonPipelineTrigger(updateOptions)
+			Expect(err).ToNot(BeNil())
+			cdTektonPipelineService.SetEnableGzipCompression(true)
+			_, _, err = cdTektonPipelineService.UpdateTektonPipelineTrigger(updateOptions)
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("invalid trigger filter"))
+			cdTektonPipelineService.SetEnableGzipCompression(false)
+			updateOptions.SetTriggerPatch(map[string]interface{}{"filter": 1})
+			_, _, err = cdTektonPipelineService.UpdateTektonPipelineTrigger(updateOptions)
+			Expect(err).ToNot(BeNil())
+			Expect(config.requestLog()).To(BeEmpty())
+
+			cdTektonPipelineService.SetValidateTriggerFilters(false)
+			Expect(cdTektonPipelineService.GetValidateTriggerFilters()).To(BeFalse())
+			_, _, err = cdTektonPipelineService.CreateTektonPipelineTrigger(createOptions)
+			Expect(err).To(BeNil())
+			Expect(config.requestLog()).To(Equal([]string{"POST /tekton_pipelines/PipelineID/triggers"}))
+		})
+	})
+})
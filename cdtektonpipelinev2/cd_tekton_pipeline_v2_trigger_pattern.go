@@ -0,0 +1,458 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"unicode"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Kinds of globNode.
+const (
+	globLiteral = iota
+	globAny
+	globStar
+	globBracket
+	globExtended
+)
+
+// globNode is an element of a pattern: a literal character, `?`, `*`, a bracket expression or an extended pattern.
+type globNode struct {
+	kind    int
+	literal rune
+
+	// The bracket expression.
+	negated bool
+	matches []func(rune) bool
+
+	// The extended pattern: its operator, one of `?*+@!`, and its alternatives.
+	operator     rune
+	alternatives []*globSequence
+}
+
+// globSequence is a sequence of pattern elements, identified for the memoization of the matches.
+type globSequence struct {
+	id    int
+	nodes []globNode
+}
+
+// globClasses are the character classes of the bracket expressions.
+var globClasses = map[string]func(rune) bool{
+	"alnum":  func(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) },
+	"alpha":  unicode.IsLetter,
+	"ascii":  func(r rune) bool { return r < 0x80 },
+	"blank":  func(r rune) bool { return r == ' ' || r == '\t' },
+	"cntrl":  unicode.IsControl,
+	"digit":  func(r rune) bool { return r >= '0' && r <= '9' },
+	"graph":  func(r rune) bool { return unicode.IsGraphic(r) && !unicode.IsSpace(r) },
+	"lower":  unicode.IsLower,
+	"print":  unicode.IsPrint,
+	"punct":  unicode.IsPunct,
+	"space":  unicode.IsSpace,
+	"upper":  unicode.IsUpper,
+	"word":   func(r rune) bool { return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) },
+	"xdigit": func(r rune) bool { return strings.ContainsRune("0123456789abcdefABCDEF", r) },
+}
+
+// TriggerPattern : The compiled glob pattern of the source of an SCM trigger, which selects the branches and tags that
+// fire the trigger.
+type TriggerPattern struct {
+	// The pattern.
+	Pattern string
+
+	// Whether the pattern starts with `!`, and matches the names that the rest of the pattern does not match.
+	Negated bool
+
+	sequence  *globSequence
+	sequences int
+}
+
+// CompileTriggerPattern compiles the pattern of the source of an SCM trigger (TriggerSourceProperties.Pattern). The
+// pattern follows the pattern matching of Bash 4.3, with the extglob option enabled:
+//   - `*` matches any string, including `/`, and `?` matches any character;
+//   - `[...]` matches one of the enclosed characters, ranges such as `a-z` and classes such as `[:digit:]`, and
+//     `[!...]` or `[^...]` any other character;
+//   - `?(list)`, `*(list)`, `+(list)` and `@(list)` match zero or one, zero or more, one or more, and exactly one
+//     occurrence of the `|`-separated patterns of the list, and `!(list)` anything except one of them;
+//   - `\` quotes the next character.
+//
+// As documented by the service, a pattern starting with `!` (and not with `!(`) matches the names that the rest of
+// the pattern does not match. Unterminated brackets and parentheses are matched literally, as Bash does.
+func CompileTriggerPattern(pattern string) (result *TriggerPattern, err error) {
+	if pattern == "" {
+		err = core.SDKErrorf(nil, "the trigger pattern cannot be empty", "invalid-trigger-pattern", common.GetComponentInfo())
+		return
+	}
+	result = &TriggerPattern{Pattern: pattern}
+	runes := []rune(pattern)
+	if runes[0] == '!' && (len(runes) == 1 || runes[1] != '(') {
+		result.Negated = true
+		runes = runes[1:]
+	}
+	result.sequence = result.parse(runes)
+	return
+}
+
+// Matches tells whether the pattern matches a branch or tag name.
+func (pattern *TriggerPattern) Matches(name string) bool {
+	matcher := &globMatcher{text: []rune(name), memo: make(map[[5]int]bool)}
+	return matcher.match(pattern.sequence, 0, 0, len(matcher.text)) != pattern.Negated
+}
+
+// MatchTriggerPattern tells whether the pattern of the source of an SCM trigger matches a branch or tag name.
+func MatchTriggerPattern(pattern string, name string) (matches bool, err error) {
+	compiled, err := CompileTriggerPattern(pattern)
+	if err != nil {
+		return
+	}
+	return compiled.Matches(name), nil
+}
+
+// parse parses a sequence of pattern elements.
+func (pattern *TriggerPattern) parse(runes []rune) *globSequence {
+	sequence := &globSequence{id: pattern.sequences}
+	pattern.sequences++
+	for index := 0; index < len(runes); index++ {
+		r := runes[index]
+		if strings.ContainsRune("?*+@!", r) && index+1 < len(runes) && runes[index+1] == '(' {
+			if alternatives, end := splitGlobAlternatives(runes, index+2); end > 0 {
+				node := globNode{kind: globExtended, operator: r}
+				for _, alternative := range alternatives {
+					node.alternatives = append(node.alternatives, pattern.parse(alternative))
+				}
+				sequence.nodes = append(sequence.nodes, node)
+				index = end
+				continue
+			}
+		}
+		switch r {
+		case '?':
+			sequence.nodes = append(sequence.nodes, globNode{kind: globAny})
+		case '*':
+			if len(sequence.nodes) == 0 || sequence.nodes[len(sequence.nodes)-1].kind != globStar {
+				sequence.nodes = append(sequence.nodes, globNode{kind: globStar})
+			}
+		case '[':
+			if node, end := parseGlobBracket(runes, index+1); end > 0 {
+				sequence.nodes = append(sequence.nodes, node)
+				index = end
+				continue
+			}
+			sequence.nodes = append(sequence.nodes, globNode{kind: globLiteral, literal: r})
+		case '\\':
+			if index+1 < len(runes) {
+				index++
+			}
+			sequence.nodes = append(sequence.nodes, globNode{kind: globLiteral, literal: runes[index]})
+		default:
+			sequence.nodes = append(sequence.nodes, globNode{kind: globLiteral, literal: r})
+		}
+	}
+	return sequence
+}
+
+// splitGlobAlternatives splits the list of an extended pattern starting at the specified index, and returns the
+// index of the closing parenthesis, or 0 if there is none.
+func splitGlobAlternatives(runes []rune, start int) (alternatives [][]rune, end int) {
+	depth := 0
+	begin := start
+	for index := start; index < len(runes); index++ {
+		switch runes[index] {
+		case '\\':
+			index++
+		case '[':
+			if _, bracketEnd := parseGlobBracket(runes, index+1); bracketEnd > 0 {
+				index = bracketEnd
+			}
+		case '(':
+			depth++
+		case '|':
+			if depth == 0 {
+				alternatives = append(alternatives, runes[begin:index])
+				begin = index + 1
+			}
+		case ')':
+			if depth == 0 {
+				return append(alternatives, runes[begin:index]), index
+			}
+			depth--
+		}
+	}
+	return nil, 0
+}
+
+// parseGlobBracket parses the bracket expression starting after the `[` at the specified index, and returns the
+// index of the closing `]`, or 0 if there is none.
+func parseGlobBracket(runes []rune, start int) (node globNode, end int) {
+	node.kind = globBracket
+	index := start
+	if index < len(runes) && (runes[index] == '!' || runes[index] == '^') {
+		node.negated = true
+		index++
+	}
+	for first := true; index < len(runes); first = false {
+		r := runes[index]
+		if r == ']' && !first {
+			return node, index
+		}
+		if r == '[' && index+1 < len(runes) && strings.ContainsRune(":=.", runes[index+1]) {
+			delimiter := runes[index+1]
+			if closing := slices.Index(runes[index+2:], delimiter); closing >= 0 && index+3+closing < len(runes) && runes[index+3+closing] == ']' {
+				name := string(runes[index+2 : index+2+closing])
+				switch {
+				case delimiter == ':' && globClasses[name] != nil:
+					node.matches = append(node.matches, globClasses[name])
+				case delimiter != ':' && len([]rune(name)) == 1:
+					literal := []rune(name)[0]
+					node.matches = append(node.matches, func(c rune) bool { return c == literal })
+				default:
+					// An unknown class matches no character.
+					node.matches = append(node.matches, func(rune) bool { return false })
+				}
+				index += 4 + closing
+				continue
+			}
+		}
+		if r == '\\' && index+1 < len(runes) {
+			index++
+			r = runes[index]
+		}
+		low := r
+		index++
+		if index+1 < len(runes) && runes[index] == '-' && runes[index+1] != ']' {
+			high := runes[index+1]
+			index += 2
+			if high == '\\' && index < len(runes) {
+				high = runes[index]
+				index++
+			}
+			node.matches = append(node.matches, func(c rune) bool { return c >= low && c <= high })
+			continue
+		}
+		node.matches = append(node.matches, func(c rune) bool { return c == low })
+	}
+	return globNode{}, 0
+}
+
+// globMatcher matches a text against a pattern, memoizing the matches of the elements of the pattern with the parts
+// of the text.
+type globMatcher struct {
+	text []rune
+	memo map[[5]int]bool
+}
+
+// match tells whether the elements of a sequence from the specified index match the text from start to end.
+func (matcher *globMatcher) match(sequence *globSequence, index int, start int, end int) bool {
+	if index == len(sequence.nodes) {
+		return start == end
+	}
+	key := [5]int{sequence.id, index, start, end, 0}
+	if matched, found := matcher.memo[key]; found {
+		return matched
+	}
+	matched := false
+	node := &sequence.nodes[index]
+	switch node.kind {
+	case globLiteral:
+		matched = start < end && matcher.text[start] == node.literal && matcher.match(sequence, index+1, start+1, end)
+	case globAny:
+		matched = start < end && matcher.match(sequence, index+1, start+1, end)
+	case globBracket:
+		if start < end {
+			inBracket := slices.ContainsFunc(node.matches, func(matches func(rune) bool) bool { return matches(matcher.text[start]) })
+			matched = inBracket != node.negated && matcher.match(sequence, index+1, start+1, end)
+		}
+	case globStar:
+		for split := start; split <= end && !matched; split++ {
+			matched = matcher.match(sequence, index+1, split, end)
+		}
+	case globExtended:
+		switch node.operator {
+		case '?':
+			matched = matcher.match(sequence, index+1, start, end)
+			fallthrough
+		case '@':
+			for split := start; split <= end && !matched; split++ {
+				matched = matcher.matchAny(node, start, split) && matcher.match(sequence, index+1, split, end)
+			}
+		case '*':
+			matched = matcher.repeat(sequence, index, start, end)
+		case '+':
+			for split := start; split <= end && !matched; split++ {
+				matched = matcher.matchAny(node, start, split) && matcher.repeat(sequence, index, split, end)
+			}
+		case '!':
+			for split := start; split <= end && !matched; split++ {
+				matched = !matcher.matchAny(node, start, split) && matcher.match(sequence, index+1, split, end)
+			}
+		}
+	}
+	matcher.memo[key] = matched
+	return matched
+}
+
+// repeat tells whether zero or more occurrences of the alternatives of the extended pattern at the specified index,
+// followed by the rest of the sequence, match the text from start to end.
+func (matcher *globMatcher) repeat(sequence *globSequence, index int, start int, end int) bool {
+	key := [5]int{sequence.id, index, start, end, 1}
+	if matched, found := matcher.memo[key]; found {
+		return matched
+	}
+	matched := matcher.match(sequence, index+1, start, end)
+	for split := start + 1; split <= end && !matched; split++ {
+		matched = matcher.matchAny(&sequence.nodes[index], start, split) && matcher.repeat(sequence, index, split, end)
+	}
+	matcher.memo[key] = matched
+	return matched
+}
+
+// matchAny tells whether one of the alternatives of an extended pattern matches the text from start to end.
+func (matcher *globMatcher) matchAny(node *globNode, start int, end int) bool {
+	return slices.ContainsFunc(node.alternatives, func(alternative *globSequence) bool {
+		return matcher.match(alternative, 0, start, end)
+	})
+}
+
+// MatchTektonPipelineTriggersOptions : The MatchTektonPipelineTriggers options.
+type MatchTektonPipelineTriggersOptions struct {
+	// The Tekton pipeline ID.
+	PipelineID *string `json:"pipeline_id" validate:"required,ne="`
+
+	// The name of the branch or tag. Tags are specified as `refs/tags/<name>`, and branches either by their name or as
+	// `refs/heads/<name>`.
+	Ref *string `json:"ref" validate:"required,ne="`
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewMatchTektonPipelineTriggersOptions : Instantiate MatchTektonPipelineTriggersOptions
+func (*CdTektonPipelineV2) NewMatchTektonPipelineTriggersOptions(pipelineID string, ref string) *MatchTektonPipelineTriggersOptions {
+	return &MatchTektonPipelineTriggersOptions{
+		PipelineID: core.StringPtr(pipelineID),
+		Ref:        core.StringPtr(ref),
+	}
+}
+
+// SetPipelineID : Allow user to set PipelineID
+func (_options *MatchTektonPipelineTriggersOptions) SetPipelineID(pipelineID string) *MatchTektonPipelineTriggersOptions {
+	_options.PipelineID = core.StringPtr(pipelineID)
+	return _options
+}
+
+// SetRef : Allow user to set Ref
+func (_options *MatchTektonPipelineTriggersOptions) SetRef(ref string) *MatchTektonPipelineTriggersOptions {
+	_options.Ref = core.StringPtr(ref)
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *MatchTektonPipelineTriggersOptions) SetHeaders(param map[string]string) *MatchTektonPipelineTriggersOptions {
+	options.Headers = param
+	return options
+}
+
+// TriggerRefMatch : An SCM trigger whose source matches a branch or tag.
+type TriggerRefMatch struct {
+	// The trigger ID.
+	ID string
+
+	// The trigger name.
+	Name string
+
+	// Whether the trigger is enabled. A disabled trigger does not fire.
+	Enabled bool
+
+	// The branch or the pattern of the source of the trigger that matches.
+	Branch  string
+	Pattern string
+}
+
+// MatchTektonPipelineTriggers : List the SCM triggers matching a branch or tag
+// This lists the SCM triggers of the pipeline whose source matches the branch or tag: the Branch of the source when it
+// is the same branch, or the Pattern of the source as matched by CompileTriggerPattern. Triggers filtered with a CEL
+// expression are not reported. The triggers are returned in the order of the pipeline.
+func (cdTektonPipeline *CdTektonPipelineV2) MatchTektonPipelineTriggers(matchTektonPipelineTriggersOptions *MatchTektonPipelineTriggersOptions) (result []TriggerRefMatch, err error) {
+	result, err = cdTektonPipeline.MatchTektonPipelineTriggersWithContext(context.Background(), matchTektonPipelineTriggersOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// MatchTektonPipelineTriggersWithContext is an alternate form of the MatchTektonPipelineTriggers method which supports a Context parameter.
+func (cdTektonPipeline *CdTektonPipelineV2) MatchTektonPipelineTriggersWithContext(ctx context.Context, matchTektonPipelineTriggersOptions *MatchTektonPipelineTriggersOptions) (result []TriggerRefMatch, err error) {
+	err = core.ValidateNotNil(matchTektonPipelineTriggersOptions, "matchTektonPipelineTriggersOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(matchTektonPipelineTriggersOptions, "matchTektonPipelineTriggersOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	triggers, _, err := cdTektonPipeline.ListTektonPipelineTriggersWithContext(ctx, &ListTektonPipelineTriggersOptions{
+		PipelineID: matchTektonPipelineTriggersOptions.PipelineID,
+		Type:       core.StringPtr(CreateTektonPipelineTriggerOptionsTypeScmConst),
+		Headers:    matchTektonPipelineTriggersOptions.Headers,
+	})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "match-list-triggers-error")
+		return
+	}
+
+	ref := *matchTektonPipelineTriggersOptions.Ref
+	name, isTag := strings.CutPrefix(ref, "refs/tags/")
+	if !isTag {
+		name = strings.TrimPrefix(ref, "refs/heads/")
+	}
+	result = []TriggerRefMatch{}
+	for _, triggerIntf := range triggers.Triggers {
+		trigger, ok := triggerIntf.(*Trigger)
+		if !ok || core.StringNilMapper(trigger.Type) != CreateTektonPipelineTriggerOptionsTypeScmConst || trigger.Source == nil || trigger.Source.Properties == nil {
+			continue
+		}
+		match := TriggerRefMatch{
+			ID:      core.StringNilMapper(trigger.ID),
+			Name:    core.StringNilMapper(trigger.Name),
+			Enabled: trigger.Enabled != nil && *trigger.Enabled,
+		}
+		properties := trigger.Source.Properties
+		switch {
+		case properties.Branch != nil && *properties.Branch != "":
+			if isTag || *properties.Branch != name {
+				continue
+			}
+			match.Branch = *properties.Branch
+		case properties.Pattern != nil && *properties.Pattern != "":
+			pattern, compileErr := CompileTriggerPattern(*properties.Pattern)
+			if compileErr != nil || !pattern.Matches(name) {
+				continue
+			}
+			match.Pattern = *properties.Pattern
+		default:
+			continue
+		}
+		result = append(result, match)
+	}
+	return
+}
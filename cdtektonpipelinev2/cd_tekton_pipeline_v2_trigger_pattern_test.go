@@ -0,0 +1,168 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"net/http/httptest"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`CdTektonPipelineV2 trigger patterns`, func() {
+	It(`Invoke MatchTriggerPattern successfully`, func() {
+		for _, test := range []struct {
+			pattern string
+			name    string
+			matches bool
+		}{
+			{"main", "main", true},
+			{"main", "main2", false},
+			{"*master", "my-master", true},
+			{"release/*", "release/1.0", true},
+			{"release/*", "release/1.0/hotfix", true},
+			{"release/*", "release", false},
+			{"release/*", "release/", true},
+			{"v?.?", "v1.2", true},
+			{"v?.?", "v1.22", false},
+			{"!test", "test", false},
+			{"!test", "main", true},
+			{"!feature/*", "feature/login", false},
+			{"!feature/*", "fix/login", true},
+			{"!", "", false},
+			{"!", "main", true},
+			{"[[:digit:]]*", "1a", true},
+			{"[[:upper:]]*", "Main", true},
+			{"[[:upper:]]*", "main", false},
+			{"[!a-c]x", "dx", true},
+			{"[^a-c]x", "bx", false},
+			{"[]]", "]", true},
+			{"[!]]", "a", true},
+			{"[a-]", "-", true},
+			{"[[=a=]]", "a", true},
+			{"[[:nope:]]", "a", false},
+			{"a[b", "a[b", true},
+			{`\*`, "*", true},
+			{`\*`, "a", false},
+			{`v[\]]`, "v]", true},
+			{"!(main|develop)", "main", false},
+			{"!(main|develop)", "feature", true},
+			{"!(foo)bar", "foobar", false},
+			{"feature/!(*wip*)", "feature/login", true},
+			{"feature/!(*wip*)", "feature/login-wip", false},
+			{"@(main|release/*)", "release/2", true},
+			{"@(main|release/*)", "develop", false},
+			{"?(hot)fix", "fix", true},
+			{"?(hot)fix", "hotfix", true},
+			{"?(hot)fix", "hothotfix", false},
+			{"*(ab)", "", true},
+			{"*(ab)", "ababab", true},
+			{"*(ab)", "aba", false},
+			{"v+([0-9]).+([0-9]).+([0-9])", "v1.22.3", true},
+			{"v+([0-9]).+([0-9]).+([0-9])", "v1.22", false},
+			{"v+([0-9]).+([0-9]).+([0-9])", "v1.x.3", false},
+			{"@(a|@(b|c)d)", "cd", true},
+			{"@(a|[|])", "|", true},
+			{"@(a", "@(a", true},
+			{"*(a|)b", "aab", true},
+		} {
+			matches, err := cdtektonpipelinev2.MatchTriggerPattern(test.pattern, test.name)
+			Expect(err).To(BeNil())
+			Expect(matches).To(Equal(test.matches), test.pattern+" "+test.name)
+		}
+
+		pattern, err := cdtektonpipelinev2.CompileTriggerPattern("!release/*")
+		Expect(err).To(BeNil())
+		Expect(pattern.Negated).To(BeTrue())
+		pattern, err = cdtektonpipelinev2.CompileTriggerPattern("!(release/*)")
+		Expect(err).To(BeNil())
+		Expect(pattern.Negated).To(BeFalse())
+		Expect(pattern.Matches("main")).To(BeTrue())
+	})
+	It(`Invoke MatchTriggerPattern with error: Empty pattern`, func() {
+		_, err := cdtektonpipelinev2.MatchTriggerPattern("", "main")
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(Equal("the trigger pattern cannot be empty"))
+	})
+
+	Describe(`MatchTektonPipelineTriggers`, func() {
+		var config *mockPipelineConfig
+		var testServer *httptest.Server
+		var cdTektonPipelineService *cdtektonpipelinev2.CdTektonPipelineV2
+
+		BeforeEach(func() {
+			config = newMockPipelineConfig("PipelineID")
+			scmTrigger := func(id string, enabled bool, key string, value string) map[string]interface{} {
+				return map[string]interface{}{
+					"id": id, "name": "on-" + id, "type": "scm", "event_listener": "listener", "enabled": enabled,
+					"source": map[string]interface{}{"type": "git", "properties": map[string]interface{}{
+						"url": "https://github.com/org/app", key: value,
+					}},
+				}
+			}
+			config.triggers = []map[string]interface{}{
+				scmTrigger("main", true, "branch", "main"),
+				scmTrigger("releases", true, "pattern", "release/*"),
+				scmTrigger("tags", true, "pattern", "v+([0-9]).+([0-9]).+([0-9])"),
+				scmTrigger("others", false, "pattern", "!@(main|release/*)"),
+				{"id": "manual", "name": "manual", "type": "manual", "event_listener": "listener"},
+			}
+			testServer = config.server()
+			var serviceErr error
+			cdTektonPipelineService, serviceErr = cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		It(`Invoke MatchTektonPipelineTriggers successfully`, func() {
+			matches, err := cdTektonPipelineService.MatchTektonPipelineTriggers(cdTektonPipelineService.NewMatchTektonPipelineTriggersOptions("PipelineID", "main"))
+			Expect(err).To(BeNil())
+			Expect(matches).To(Equal([]cdtektonpipelinev2.TriggerRefMatch{{ID: "main", Name: "on-main", Enabled: true, Branch: "main"}}))
+			Expect(config.requestLog()).To(Equal([]string{"GET /tekton_pipelines/PipelineID/triggers"}))
+
+			matches, err = cdTektonPipelineService.MatchTektonPipelineTriggers(cdTektonPipelineService.NewMatchTektonPipelineTriggersOptions("PipelineID", "refs/heads/release/1.0/hotfix"))
+			Expect(err).To(BeNil())
+			Expect(matches).To(Equal([]cdtektonpipelinev2.TriggerRefMatch{{ID: "releases", Name: "on-releases", Enabled: true, Pattern: "release/*"}}))
+
+			matches, err = cdTektonPipelineService.MatchTektonPipelineTriggers(cdTektonPipelineService.NewMatchTektonPipelineTriggersOptions("PipelineID", "refs/tags/v1.2.3"))
+			Expect(err).To(BeNil())
+			Expect(matches).To(HaveLen(2))
+			Expect(matches[0].Name).To(Equal("on-tags"))
+			Expect(matches[1]).To(Equal(cdtektonpipelinev2.TriggerRefMatch{ID: "others", Name: "on-others", Enabled: false, Pattern: "!@(main|release/*)"}))
+
+			matches, err = cdTektonPipelineService.MatchTektonPipelineTriggers(cdTektonPipelineService.NewMatchTektonPipelineTriggersOptions("PipelineID", "refs/tags/main"))
+			Expect(err).To(BeNil())
+			Expect(matches).To(BeEmpty())
+		})
+		It(`Invoke MatchTektonPipelineTriggers with error: Operation validation and request error`, func() {
+			_, err := cdTektonPipelineService.MatchTektonPipelineTriggers(nil)
+			Expect(err).ToNot(BeNil())
+			_, err = cdTektonPipelineService.MatchTektonPipelineTriggers(cdTektonPipelineService.NewMatchTektonPipelineTriggersOptions("PipelineID", ""))
+			Expect(err).ToNot(BeNil())
+			config.failing["GET /tekton_pipelines/PipelineID/triggers"] = true
+			_, err = cdTektonPipelineService.MatchTektonPipelineTriggers(cdTektonPipelineService.NewMatchTektonPipelineTriggersOptions("PipelineID", "main"))
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
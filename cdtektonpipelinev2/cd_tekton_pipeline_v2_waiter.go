@@ -0,0 +1,314 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"time"
+
+	common "github.com/IBM/continuous-delivery-go-sdk/v2/common"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// Default polling settings used by WaitForTektonPipelineRun when the corresponding option is not set.
+const (
+	DefaultWaitPollInterval      = 5 * time.Second
+	DefaultWaitMaxPollInterval   = 60 * time.Second
+	DefaultWaitBackoffMultiplier = 1.5
+)
+
+// PipelineRunOutcome : The final outcome of a pipeline run observed by WaitForTektonPipelineRun.
+type PipelineRunOutcome string
+
+// Constants associated with the PipelineRunOutcome type.
+const (
+	PipelineRunOutcomeSucceededConst PipelineRunOutcome = "succeeded"
+	PipelineRunOutcomeFailedConst    PipelineRunOutcome = "failed"
+	PipelineRunOutcomeErrorConst     PipelineRunOutcome = "error"
+	PipelineRunOutcomeCancelledConst PipelineRunOutcome = "cancelled"
+	PipelineRunOutcomeTimedOutConst  PipelineRunOutcome = "timed_out"
+)
+
+// PipelineRunStatusCallback is invoked by WaitForTektonPipelineRun each time the observed status of a run changes.
+// The previous status is empty for the first observation.
+type PipelineRunStatusCallback func(previousStatus string, run *PipelineRun)
+
+// WaitForTektonPipelineRunOptions : The WaitForTektonPipelineRun options.
+type WaitForTektonPipelineRunOptions struct {
+	// The Tekton pipeline ID.
+	PipelineID *string `json:"pipeline_id" validate:"required,ne="`
+
+	// The Tekton pipeline run ID.
+	ID *string `json:"id" validate:"required,ne="`
+
+	// Delay before the second poll. Defaults to DefaultWaitPollInterval.
+	PollInterval time.Duration
+
+	// Upper bound for the delay between two polls. Defaults to DefaultWaitMaxPollInterval.
+	MaxPollInterval time.Duration
+
+	// Factor applied to the delay after each poll. Defaults to DefaultWaitBackoffMultiplier.
+	BackoffMultiplier float64
+
+	// Fraction of the delay, between 0 and 1, that is randomly added or removed. Zero disables jitter.
+	Jitter float64
+
+	// Maximum time to wait for the run. When zero, only the deadline of the context applies.
+	Timeout time.Duration
+
+	// Optional callback invoked on every status transition.
+	OnStatusChange PipelineRunStatusCallback
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewWaitForTektonPipelineRunOptions : Instantiate WaitForTektonPipelineRunOptions
+func (*CdTektonPipelineV2) NewWaitForTektonPipelineRunOptions(pipelineID string, id string) *WaitForTektonPipelineRunOptions {
+	return &WaitForTektonPipelineRunOptions{
+		PipelineID: core.StringPtr(pipelineID),
+		ID:         core.StringPtr(id),
+	}
+}
+
+// SetPipelineID : Allow user to set PipelineID
+func (_options *WaitForTektonPipelineRunOptions) SetPipelineID(pipelineID string) *WaitForTektonPipelineRunOptions {
+	_options.PipelineID = core.StringPtr(pipelineID)
+	return _options
+}
+
+// SetID : Allow user to set ID
+func (_options *WaitForTektonPipelineRunOptions) SetID(id string) *WaitForTektonPipelineRunOptions {
+	_options.ID = core.StringPtr(id)
+	return _options
+}
+
+// SetPollInterval : Allow user to set PollInterval
+func (_options *WaitForTektonPipelineRunOptions) SetPollInterval(pollInterval time.Duration) *WaitForTektonPipelineRunOptions {
+	_options.PollInterval = pollInterval
+	return _options
+}
+
+// SetMaxPollInterval : Allow user to set MaxPollInterval
+func (_options *WaitForTektonPipelineRunOptions) SetMaxPollInterval(maxPollInterval time.Duration) *WaitForTektonPipelineRunOptions {
+	_options.MaxPollInterval = maxPollInterval
+	return _options
+}
+
+// SetBackoffMultiplier : Allow user to set BackoffMultiplier
+func (_options *WaitForTektonPipelineRunOptions) SetBackoffMultiplier(backoffMultiplier float64) *WaitForTektonPipelineRunOptions {
+	_options.BackoffMultiplier = backoffMultiplier
+	return _options
+}
+
+// SetJitter : Allow user to set Jitter
+func (_options *WaitForTektonPipelineRunOptions) SetJitter(jitter float64) *WaitForTektonPipelineRunOptions {
+	_options.Jitter = jitter
+	return _options
+}
+
+// SetTimeout : Allow user to set Timeout
+func (_options *WaitForTektonPipelineRunOptions) SetTimeout(timeout time.Duration) *WaitForTektonPipelineRunOptions {
+	_options.Timeout = timeout
+	return _options
+}
+
+// SetOnStatusChange : Allow user to set OnStatusChange
+func (_options *WaitForTektonPipelineRunOptions) SetOnStatusChange(onStatusChange PipelineRunStatusCallback) *WaitForTektonPipelineRunOptions {
+	_options.OnStatusChange = onStatusChange
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *WaitForTektonPipelineRunOptions) SetHeaders(param map[string]string) *WaitForTektonPipelineRunOptions {
+	options.Headers = param
+	return options
+}
+
+// WaitForTektonPipelineRun : Wait for a pipeline run to finish
+// This polls the pipeline run identified by `{id}` until it reaches a terminal status and returns the last observed
+// run together with its outcome.
+func (cdTektonPipeline *CdTektonPipelineV2) WaitForTektonPipelineRun(waitForTektonPipelineRunOptions *WaitForTektonPipelineRunOptions) (result *PipelineRun, outcome PipelineRunOutcome, err error) {
+	result, outcome, err = cdTektonPipeline.WaitForTektonPipelineRunWithContext(context.Background(), waitForTektonPipelineRunOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// WaitForTektonPipelineRunWithContext is an alternate form of the WaitForTektonPipelineRun method which supports a Context parameter.
+// Reaching the deadline of the context or the configured timeout yields PipelineRunOutcomeTimedOutConst without an
+// error; cancelling the context returns the cancellation error.
+func (cdTektonPipeline *CdTektonPipelineV2) WaitForTektonPipelineRunWithContext(ctx context.Context, waitForTektonPipelineRunOptions *WaitForTektonPipelineRunOptions) (result *PipelineRun, outcome PipelineRunOutcome, err error) {
+	err = core.ValidateNotNil(waitForTektonPipelineRunOptions, "waitForTektonPipelineRunOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(waitForTektonPipelineRunOptions, "waitForTektonPipelineRunOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+
+	if waitForTektonPipelineRunOptions.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, waitForTektonPipelineRunOptions.Timeout)
+		defer cancel()
+	}
+
+	backoff := newPollBackoff(waitForTektonPipelineRunOptions.PollInterval, waitForTektonPipelineRunOptions.MaxPollInterval,
+		waitForTektonPipelineRunOptions.BackoffMultiplier, waitForTektonPipelineRunOptions.Jitter)
+
+	getOptions := &GetTektonPipelineRunOptions{
+		PipelineID: waitForTektonPipelineRunOptions.PipelineID,
+		ID:         waitForTektonPipelineRunOptions.ID,
+		Headers:    waitForTektonPipelineRunOptions.Headers,
+	}
+
+	previousStatus := ""
+	for {
+		run, _, getErr := cdTektonPipeline.GetTektonPipelineRunWithContext(ctx, getOptions)
+		if getErr != nil {
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				outcome = PipelineRunOutcomeTimedOutConst
+				return
+			}
+			err = core.RepurposeSDKProblem(getErr, "wait-get-run-error")
+			return
+		}
+		result = run
+
+		status := ""
+		if run.Status != nil {
+			status = *run.Status
+		}
+		if status != previousStatus {
+			if waitForTektonPipelineRunOptions.OnStatusChange != nil {
+				waitForTektonPipelineRunOptions.OnStatusChange(previousStatus, run)
+			}
+			previousStatus = status
+		}
+
+		if terminal, ok := pipelineRunOutcomeForStatus(ParseRunStatus(status)); ok {
+			outcome = terminal
+			return
+		}
+
+		timer := time.NewTimer(backoff.next())
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				outcome = PipelineRunOutcomeTimedOutConst
+				return
+			}
+			err = core.SDKErrorf(ctx.Err(), "", "wait-cancelled", common.GetComponentInfo())
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// pipelineRunOutcomeForStatus maps a terminal pipeline run status to its outcome.
+func pipelineRunOutcomeForStatus(status RunStatus) (outcome PipelineRunOutcome, terminal bool) {
+	switch status {
+	case RunStatusSucceededConst:
+		return PipelineRunOutcomeSucceededConst, true
+	case RunStatusFailedConst:
+		return PipelineRunOutcomeFailedConst, true
+	case RunStatusErrorConst:
+		return PipelineRunOutcomeErrorConst, true
+	case RunStatusCancelledConst:
+		return PipelineRunOutcomeCancelledConst, true
+	}
+	return "", false
+}
+
+// pollBackoff computes successive polling delays with exponential growth and random jitter.
+type pollBackoff struct {
+	interval    time.Duration
+	maxInterval time.Duration
+	multiplier  float64
+	jitter      float64
+}
+
+func newPollBackoff(interval time.Duration, maxInterval time.Duration, multiplier float64, jitter float64) *pollBackoff {
+	if interval <= 0 {
+		interval = DefaultWaitPollInterval
+	}
+	if maxInterval <= 0 {
+		maxInterval = DefaultWaitMaxPollInterval
+	}
+	if maxInterval < interval {
+		maxInterval = interval
+	}
+	if multiplier < 1 {
+		multiplier = DefaultWaitBackoffMultiplier
+	}
+	if jitter < 0 {
+		jitter = 0
+	} else if jitter > 1 {
+		jitter = 1
+	}
+	return &pollBackoff{
+		interval:    interval,
+		maxInterval: maxInterval,
+		multiplier:  multiplier,
+		jitter:      jitter,
+	}
+}
+
+// next returns the delay to use before the next poll and advances the backoff.
+func (backoff *pollBackoff) next() time.Duration {
+	delay := backoff.interval
+	if backoff.jitter > 0 {
+		delta := float64(delay) * backoff.jitter
+		delay += time.Duration(delta * (2*rand.Float64() - 1))
+	}
+
+	grown := time.Duration(float64(backoff.interval) * backoff.multiplier)
+	if grown > backoff.maxInterval {
+		grown = backoff.maxInterval
+	}
+	backoff.interval = grown
+
+	if delay < 0 {
+		delay = 0
+	}
+	return delay
+}
+
+// isTransientError tells whether a request failed for a reason that may not last: the service could not be reached,
+// or it answered with a 429 or 5xx status code.
+func isTransientError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var httpProblem *core.HTTPProblem
+	if errors.As(err, &httpProblem) {
+		if httpProblem.Response == nil {
+			return false
+		}
+		statusCode := httpProblem.Response.GetStatusCode()
+		return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
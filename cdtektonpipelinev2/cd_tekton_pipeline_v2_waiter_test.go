@@ -0,0 +1,164 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cdtektonpipelinev2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/IBM/continuous-delivery-go-sdk/v2/cdtektonpipelinev2"
+	"github.com/IBM/go-sdk-core/v5/core"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// mockPipelineRunJSON renders a minimal pipeline run response body with the specified id and status.
+func mockPipelineRunJSON(id string, status string) string {
+	return fmt.Sprintf(`{"id": "%s", "status": "%s", "definition_id": "DefinitionID", "worker": {"id": "ID"}, "pipeline_id": "PipelineID", "listener_name": "ListenerName", "trigger": {"type": "manual", "name": "start-deploy"}, "event_params_blob": "{}", "created_at": "2019-01-01T12:00:00.000Z", "run_url": "RunURL"}`, id, status)
+}
+
+// newStatusSequenceServer returns a mock server which serves the given statuses for a single pipeline run, one per
+// request, repeating the last status once the sequence is exhausted.
+func newStatusSequenceServer(path string, statuses ...string) (*httptest.Server, func() int) {
+	var mutex sync.Mutex
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		defer GinkgoRecover()
+
+		Expect(req.URL.EscapedPath()).To(Equal(path))
+		Expect(req.Method).To(Equal("GET"))
+
+		mutex.Lock()
+		status := statuses[len(statuses)-1]
+		if calls < len(statuses) {
+			status = statuses[calls]
+		}
+		calls++
+		mutex.Unlock()
+
+		res.Header().Set("Content-type", "application/json")
+		res.WriteHeader(200)
+		fmt.Fprint(res, mockPipelineRunJSON("RunID", status))
+	}))
+	return server, func() int {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return calls
+	}
+}
+
+var _ = Describe(`CdTektonPipelineV2 WaitForTektonPipelineRun`, func() {
+	var testServer *httptest.Server
+	waitPath := "/tekton_pipelines/PipelineID/pipeline_runs/RunID"
+
+	newService := func() *cdtektonpipelinev2.CdTektonPipelineV2 {
+		cdTektonPipelineService, serviceErr := cdtektonpipelinev2.NewCdTektonPipelineV2(&cdtektonpipelinev2.CdTektonPipelineV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+		Expect(cdTektonPipelineService).ToNot(BeNil())
+		return cdTektonPipelineService
+	}
+
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Invoke WaitForTektonPipelineRun until success and report transitions`, func() {
+		var calls func() int
+		testServer, calls = newStatusSequenceServer(waitPath, "queued", "queued", "running", "succeeded")
+		cdTektonPipelineService := newService()
+
+		var transitions []string
+		waitOptions := cdTektonPipelineService.NewWaitForTektonPipelineRunOptions("PipelineID", "RunID").
+			SetPollInterval(time.Millisecond).
+			SetMaxPollInterval(5 * time.Millisecond).
+			SetJitter(0.5).
+			SetOnStatusChange(func(previousStatus string, run *cdtektonpipelinev2.PipelineRun) {
+				transitions = append(transitions, previousStatus+"->"+*run.Status)
+			})
+
+		result, outcome, err := cdTektonPipelineService.WaitForTektonPipelineRun(waitOptions)
+		Expect(err).To(BeNil())
+		Expect(outcome).To(Equal(cdtektonpipelinev2.PipelineRunOutcomeSucceededConst))
+		Expect(*result.Status).To(Equal("succeeded"))
+		Expect(transitions).To(Equal([]string{"->queued", "queued->running", "running->succeeded"}))
+		Expect(calls()).To(Equal(4))
+	})
+	DescribeTable(`Invoke WaitForTektonPipelineRun with each terminal status`,
+		func(status string, expected cdtektonpipelinev2.PipelineRunOutcome) {
+			testServer, _ = newStatusSequenceServer(waitPath, "running", status)
+			cdTektonPipelineService := newService()
+
+			waitOptions := cdTektonPipelineService.NewWaitForTektonPipelineRunOptions("PipelineID", "RunID").
+				SetPollInterval(time.Millisecond)
+			_, outcome, err := cdTektonPipelineService.WaitForTektonPipelineRun(waitOptions)
+			Expect(err).To(BeNil())
+			Expect(outcome).To(Equal(expected))
+		},
+		Entry(`failed`, "failed", cdtektonpipelinev2.PipelineRunOutcomeFailedConst),
+		Entry(`error`, "error", cdtektonpipelinev2.PipelineRunOutcomeErrorConst),
+		Entry(`cancelled`, "cancelled", cdtektonpipelinev2.PipelineRunOutcomeCancelledConst),
+	)
+	It(`Invoke WaitForTektonPipelineRun with timeout`, func() {
+		testServer, _ = newStatusSequenceServer(waitPath, "waiting")
+		cdTektonPipelineService := newService()
+
+		waitOptions := cdTektonPipelineService.NewWaitForTektonPipelineRunOptions("PipelineID", "RunID").
+			SetPollInterval(5 * time.Millisecond).
+			SetTimeout(50 * time.Millisecond)
+		result, outcome, err := cdTektonPipelineService.WaitForTektonPipelineRun(waitOptions)
+		Expect(err).To(BeNil())
+		Expect(outcome).To(Equal(cdtektonpipelinev2.PipelineRunOutcomeTimedOutConst))
+		Expect(*result.Status).To(Equal("waiting"))
+	})
+	It(`Invoke WaitForTektonPipelineRunWithContext with cancelled context`, func() {
+		testServer, _ = newStatusSequenceServer(waitPath, "running")
+		cdTektonPipelineService := newService()
+
+		ctx, cancelFunc := context.WithCancel(context.Background())
+		waitOptions := cdTektonPipelineService.NewWaitForTektonPipelineRunOptions("PipelineID", "RunID").
+			SetPollInterval(time.Millisecond).
+			SetOnStatusChange(func(previousStatus string, run *cdtektonpipelinev2.PipelineRun) {
+				cancelFunc()
+			})
+		_, outcome, err := cdTektonPipelineService.WaitForTektonPipelineRunWithContext(ctx, waitOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(outcome).To(BeEmpty())
+	})
+	It(`Invoke WaitForTektonPipelineRun with error: Operation validation and request error`, func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.WriteHeader(404)
+		}))
+		cdTektonPipelineService := newService()
+
+		_, _, err := cdTektonPipelineService.WaitForTektonPipelineRun(nil)
+		Expect(err).ToNot(BeNil())
+
+		_, _, err = cdTektonPipelineService.WaitForTektonPipelineRun(new(cdtektonpipelinev2.WaitForTektonPipelineRunOptions))
+		Expect(err).ToNot(BeNil())
+
+		waitOptions := cdTektonPipelineService.NewWaitForTektonPipelineRunOptions("PipelineID", "RunID")
+		_, _, err = cdTektonPipelineService.WaitForTektonPipelineRun(waitOptions)
+		Expect(err).ToNot(BeNil())
+	})
+})